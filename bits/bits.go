@@ -0,0 +1,112 @@
+package bits
+
+import (
+	stdbits "math/bits"
+	"unsafe"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+)
+
+// 以下の関数は 型 T の値を その bit 幅の 2 の補数表現による bit 列とみなして操作する.
+
+// Width は 型 T の bit 幅を返す
+// Time: O(1)
+func Width[T math.Ints]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+// pattern は x の bit 列を 上位を 0 で埋めた uint64 として返す
+func pattern[T math.Ints](x T) uint64 {
+	w := Width[T]()
+	if w == 64 {
+		return uint64(x)
+	}
+	return uint64(x) & (1<<w - 1)
+}
+
+// OnesCount は x の立っている bit の個数を返す
+// Time: O(1)
+func OnesCount[T math.Ints](x T) int {
+	return stdbits.OnesCount64(pattern(x))
+}
+
+// Len は x を表現するのに必要な最小の bit 数 (最上位の立っている bit の位置 + 1) を返す. x = 0 の場合は 0 を返す.
+// Time: O(1)
+func Len[T math.Ints](x T) int {
+	return stdbits.Len64(pattern(x))
+}
+
+// HighestSetBit は x の最上位の立っている bit の位置 (0-indexed) を返す. x = 0 の場合は -1 を返す.
+// Time: O(1)
+func HighestSetBit[T math.Ints](x T) int {
+	return Len(x) - 1
+}
+
+// LowestSetBit は x の最下位の立っている bit の位置 (0-indexed) を返す. x = 0 の場合は -1 を返す.
+// Time: O(1)
+func LowestSetBit[T math.Ints](x T) int {
+	if x == 0 {
+		return -1
+	}
+	return stdbits.TrailingZeros64(pattern(x))
+}
+
+// IsPowerOfTwo は x が 2 の冪 (x > 0) であるかを判定する
+// Time: O(1)
+func IsPowerOfTwo[T math.Ints](x T) bool {
+	return x > 0 && x&(x-1) == 0
+}
+
+// NextPowerOfTwo は x 以上の最小の 2 の冪と error 値 nil を返す. x <= 1 の場合は 1 を返す.
+// x < 0 の場合は ErrInvalidValue が, 結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// Time: O(1)
+func NextPowerOfTwo[T math.Ints](x T) (T, error) {
+	if x < 0 {
+		return 0, errors.ErrInvalidValue
+	}
+	if x <= 1 {
+		return 1, nil
+	}
+	k := Len(x - 1)
+	// 符号付き整数型では 最上位 bit は符号 bit である
+	if _, max := math.Limits[T](); k >= Len(max) {
+		return 0, errors.ErrOverflow
+	}
+	return T(1) << k, nil
+}
+
+// Gray は x の グレイコード x ^ (x >> 1) を返す. 符号付き整数型では論理シフトとして扱う.
+// Time: O(1)
+func Gray[T math.Ints](x T) T {
+	u := pattern(x)
+	return T(u ^ u>>1)
+}
+
+// InverseGray は Gray(y) = g を満たす y を返す
+// Time: O(1)
+func InverseGray[T math.Ints](g T) T {
+	u := pattern(g)
+	for s := 1; s < 64; s <<= 1 {
+		u ^= u >> s
+	}
+	return T(u)
+}
+
+// Reverse は x の bit 列を 型 T の bit 幅の範囲で反転した値を返す
+// Time: O(1)
+func Reverse[T math.Ints](x T) T {
+	return T(stdbits.Reverse64(pattern(x)) >> (64 - Width[T]()))
+}
+
+// SetBits は x の立っている bit の位置を 昇順に並べたスライスを返す
+// Time: O(popcount(x))
+func SetBits[T math.Ints](x T) []int {
+	u := pattern(x)
+	res := make([]int, 0, stdbits.OnesCount64(u))
+	for ; u != 0; u &= u - 1 {
+		res = append(res, stdbits.TrailingZeros64(u))
+	}
+	return res
+}
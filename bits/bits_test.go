@@ -0,0 +1,124 @@
+package bits_test
+
+import (
+	"reflect"
+	"testing"
+
+	bits "github.com/hiden2000/go_ds/bits"
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestBitCounts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		x       int8
+		ones    int
+		length  int
+		highest int
+		lowest  int
+		setBits []int
+	}{
+		{name: "Zero", x: 0, ones: 0, length: 0, highest: -1, lowest: -1, setBits: []int{}},
+		{name: "One", x: 1, ones: 1, length: 1, highest: 0, lowest: 0, setBits: []int{0}},
+		{name: "Twelve", x: 12, ones: 2, length: 4, highest: 3, lowest: 2, setBits: []int{2, 3}},
+		{name: "MinusOne", x: -1, ones: 8, length: 8, highest: 7, lowest: 0, setBits: []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		{name: "Min", x: -128, ones: 1, length: 8, highest: 7, lowest: 7, setBits: []int{7}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if out := bits.OnesCount(tc.x); out != tc.ones {
+				t.Errorf("OnesCount: Expected %d, got %d instead\n", tc.ones, out)
+			}
+			if out := bits.Len(tc.x); out != tc.length {
+				t.Errorf("Len: Expected %d, got %d instead\n", tc.length, out)
+			}
+			if out := bits.HighestSetBit(tc.x); out != tc.highest {
+				t.Errorf("HighestSetBit: Expected %d, got %d instead\n", tc.highest, out)
+			}
+			if out := bits.LowestSetBit(tc.x); out != tc.lowest {
+				t.Errorf("LowestSetBit: Expected %d, got %d instead\n", tc.lowest, out)
+			}
+			if out := bits.SetBits(tc.x); !reflect.DeepEqual(out, tc.setBits) {
+				t.Errorf("SetBits: Expected %v, got %v instead\n", tc.setBits, out)
+			}
+		})
+	}
+
+	if out := bits.Width[uint16](); out != 16 {
+		t.Errorf("Expected 16, got %d instead\n", out)
+	}
+	if out := bits.OnesCount(uint64(1<<64 - 1)); out != 64 {
+		t.Errorf("Expected 64, got %d instead\n", out)
+	}
+}
+
+func TestPowerOfTwo(t *testing.T) {
+	testCases := []struct {
+		name string
+		x    int8
+		exp  int8
+		err  error
+	}{
+		{name: "Neg", x: -3, err: errors.ErrInvalidValue},
+		{name: "Zero", x: 0, exp: 1},
+		{name: "One", x: 1, exp: 1},
+		{name: "Three", x: 3, exp: 4},
+		{name: "Exact", x: 64, exp: 64},
+		{name: "Overflow", x: 65, err: errors.ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := bits.NextPowerOfTwo(tc.x)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && out != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	if out, err := bits.NextPowerOfTwo(uint8(129)); err != errors.ErrOverflow {
+		t.Errorf("Expected %v, got %d (%v) instead\n", errors.ErrOverflow, out, err)
+	}
+	if out, err := bits.NextPowerOfTwo(uint8(128)); err != nil || out != 128 {
+		t.Errorf("Expected 128, got %d (%v) instead\n", out, err)
+	}
+	for x, exp := range map[int]bool{-4: false, 0: false, 1: true, 6: false, 1024: true} {
+		if out := bits.IsPowerOfTwo(x); out != exp {
+			t.Errorf("IsPowerOfTwo(%d): Expected %v, got %v instead\n", x, exp, out)
+		}
+	}
+}
+
+func TestGray(t *testing.T) {
+	// 隣り合うグレイコードはちょうど 1 bit だけ異なる
+	for x := uint16(0); x < 1<<10; x++ {
+		g := bits.Gray(x)
+		if diff := bits.OnesCount(g ^ bits.Gray(x+1)); diff != 1 {
+			t.Fatalf("Gray(%d) and Gray(%d) differ in %d bits\n", x, x+1, diff)
+		}
+		if out := bits.InverseGray(g); out != x {
+			t.Fatalf("InverseGray(%d): Expected %d, got %d instead\n", g, x, out)
+		}
+	}
+	for x := -128; x < 128; x++ {
+		if out := bits.InverseGray(bits.Gray(int8(x))); out != int8(x) {
+			t.Fatalf("InverseGray(Gray(%d)): got %d instead\n", x, out)
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	if out := bits.Reverse(uint8(0b00010110)); out != 0b01101000 {
+		t.Errorf("Expected %08b, got %08b instead\n", 0b01101000, out)
+	}
+	if out := bits.Reverse(int8(1)); out != -128 {
+		t.Errorf("Expected -128, got %d instead\n", out)
+	}
+	if out := bits.Reverse(uint32(1)); out != 1<<31 {
+		t.Errorf("Expected %d, got %d instead\n", uint32(1<<31), out)
+	}
+}
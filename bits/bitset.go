@@ -0,0 +1,214 @@
+package bits
+
+import (
+	stdbits "math/bits"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Bitset は 長さ N の bit 列を 64 bit 単位で保持する可変長の bitset である.
+// 集合演算やシフトを O(N/64) で行えるため bitset を用いた DP に適する.
+type Bitset struct {
+	n     int
+	words []uint64 // 末尾の語の N 以上の位置の bit は常に 0 に保たれる
+}
+
+// New は 長さ n の 全ての bit が 0 である Bitset と error 値 nil を返す.
+// n < 0 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(N/64)
+func New(n int) (*Bitset, error) {
+	if n < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	return &Bitset{n: n, words: make([]uint64, (n+63)/64)}, nil
+}
+
+// Len は bit 列の長さを返す
+// Time: O(1)
+func (b *Bitset) Len() int {
+	return b.n
+}
+
+// Test は 位置 i の bit が立っているかと error 値 nil を返す.
+// i が範囲外の場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (b *Bitset) Test(i int) (bool, error) {
+	if i < 0 || i >= b.n {
+		return false, errors.ErrInvalidIndex
+	}
+	return b.words[i>>6]>>(i&63)&1 == 1, nil
+}
+
+// Set は 位置 i の bit を立て, error 値 nil を返す.
+// i が範囲外の場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (b *Bitset) Set(i int) error {
+	if i < 0 || i >= b.n {
+		return errors.ErrInvalidIndex
+	}
+	b.words[i>>6] |= 1 << (i & 63)
+	return nil
+}
+
+// Reset は 位置 i の bit を 0 にし, error 値 nil を返す.
+// i が範囲外の場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (b *Bitset) Reset(i int) error {
+	if i < 0 || i >= b.n {
+		return errors.ErrInvalidIndex
+	}
+	b.words[i>>6] &^= 1 << (i & 63)
+	return nil
+}
+
+// Flip は 位置 i の bit を反転し, error 値 nil を返す.
+// i が範囲外の場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (b *Bitset) Flip(i int) error {
+	if i < 0 || i >= b.n {
+		return errors.ErrInvalidIndex
+	}
+	b.words[i>>6] ^= 1 << (i & 63)
+	return nil
+}
+
+// Count は 立っている bit の個数を返す
+// Time: O(N/64)
+func (b *Bitset) Count() int {
+	c := 0
+	for _, w := range b.words {
+		c += stdbits.OnesCount64(w)
+	}
+	return c
+}
+
+// Clone は b の複製を返す
+// Time: O(N/64)
+func (b *Bitset) Clone() *Bitset {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	return &Bitset{n: b.n, words: words}
+}
+
+// And は b を b と other の bit 毎の論理積で更新し, error 値 nil を返す.
+// 長さが異なる場合は ErrInvalidValue が error 値として返される.
+// Time: O(N/64)
+func (b *Bitset) And(other *Bitset) error {
+	if b.n != other.n {
+		return errors.ErrInvalidValue
+	}
+	for i, w := range other.words {
+		b.words[i] &= w
+	}
+	return nil
+}
+
+// Or は b を b と other の bit 毎の論理和で更新し, error 値 nil を返す.
+// 長さが異なる場合は ErrInvalidValue が error 値として返される.
+// Time: O(N/64)
+func (b *Bitset) Or(other *Bitset) error {
+	if b.n != other.n {
+		return errors.ErrInvalidValue
+	}
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+	return nil
+}
+
+// Xor は b を b と other の bit 毎の排他的論理和で更新し, error 値 nil を返す.
+// 長さが異なる場合は ErrInvalidValue が error 値として返される.
+// Time: O(N/64)
+func (b *Bitset) Xor(other *Bitset) error {
+	if b.n != other.n {
+		return errors.ErrInvalidValue
+	}
+	for i, w := range other.words {
+		b.words[i] ^= w
+	}
+	return nil
+}
+
+// Lsh は 位置 i の bit を位置 i+k に移した (長さ N を超える bit は捨てる) 新たな Bitset を返す
+// Time: O(N/64)
+func (b *Bitset) Lsh(k uint) *Bitset {
+	res := &Bitset{n: b.n, words: make([]uint64, len(b.words))}
+	if k >= uint(b.n) {
+		return res
+	}
+	q, r := int(k>>6), k&63
+	for i := len(b.words) - 1; i >= q; i-- {
+		w := b.words[i-q] << r
+		if r != 0 && i-q-1 >= 0 {
+			w |= b.words[i-q-1] >> (64 - r)
+		}
+		res.words[i] = w
+	}
+	res.trim()
+	return res
+}
+
+// Rsh は 位置 i の bit を位置 i-k に移した (負の位置となる bit は捨てる) 新たな Bitset を返す
+// Time: O(N/64)
+func (b *Bitset) Rsh(k uint) *Bitset {
+	res := &Bitset{n: b.n, words: make([]uint64, len(b.words))}
+	if k >= uint(b.n) {
+		return res
+	}
+	q, r := int(k>>6), k&63
+	for i := 0; i+q < len(b.words); i++ {
+		w := b.words[i+q] >> r
+		if r != 0 && i+q+1 < len(b.words) {
+			w |= b.words[i+q+1] << (64 - r)
+		}
+		res.words[i] = w
+	}
+	return res
+}
+
+// trim は 末尾の語の 長さ N 以上の位置の bit を 0 にする
+func (b *Bitset) trim() {
+	if r := b.n & 63; r != 0 {
+		b.words[len(b.words)-1] &= 1<<r - 1
+	}
+}
+
+// FindFirst は 立っている bit のうち最小の位置を返す. 存在しない場合は -1 を返す.
+// Time: O(N/64)
+func (b *Bitset) FindFirst() int {
+	return b.FindNext(-1)
+}
+
+// FindNext は 位置 i より大きい 立っている bit のうち最小の位置を返す. 存在しない場合は -1 を返す.
+// Time: O(N/64)
+func (b *Bitset) FindNext(i int) int {
+	// i + 1 のオーバーフローを避けるため 加算の前に判定する
+	if i >= b.n-1 {
+		return -1
+	}
+	if i++; i < 0 {
+		i = 0
+	}
+	if i >= b.n {
+		return -1
+	}
+	q := i >> 6
+	if w := b.words[q] >> (i & 63); w != 0 {
+		return i + stdbits.TrailingZeros64(w)
+	}
+	for q++; q < len(b.words); q++ {
+		if b.words[q] != 0 {
+			return q<<6 + stdbits.TrailingZeros64(b.words[q])
+		}
+	}
+	return -1
+}
+
+// String は 位置 0 から順に bit を '0' と '1' で表した文字列を返す
+func (b *Bitset) String() string {
+	buf := make([]byte, b.n)
+	for i := range buf {
+		buf[i] = '0' + byte(b.words[i>>6]>>(i&63)&1)
+	}
+	return string(buf)
+}
@@ -0,0 +1,184 @@
+package bits_test
+
+import (
+	stdmath "math"
+	"math/rand"
+	"testing"
+
+	bits "github.com/hiden2000/go_ds/bits"
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// randomBitset は 長さ n のランダムな Bitset と 対応する []bool を返す
+func randomBitset(t *testing.T, rng *rand.Rand, n int) (*bits.Bitset, []bool) {
+	t.Helper()
+	b, err := bits.New(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	naive := make([]bool, n)
+	for i := range naive {
+		if rng.Intn(3) == 0 {
+			naive[i] = true
+			b.Set(i)
+		}
+	}
+	return b, naive
+}
+
+func toBools(b *bits.Bitset) []bool {
+	res := make([]bool, b.Len())
+	for i := range res {
+		res[i], _ = b.Test(i)
+	}
+	return res
+}
+
+func equalBools(a, b []bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBitsetOps(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 63, 64, 65, 200} {
+		a, na := randomBitset(t, rng, n)
+		b, nb := randomBitset(t, rng, n)
+
+		ops := []struct {
+			name  string
+			op    func(*bits.Bitset, *bits.Bitset) error
+			naive func(x, y bool) bool
+		}{
+			{name: "And", op: (*bits.Bitset).And, naive: func(x, y bool) bool { return x && y }},
+			{name: "Or", op: (*bits.Bitset).Or, naive: func(x, y bool) bool { return x || y }},
+			{name: "Xor", op: (*bits.Bitset).Xor, naive: func(x, y bool) bool { return x != y }},
+		}
+		for _, op := range ops {
+			c := a.Clone()
+			if err := op.op(c, b); err != nil {
+				t.Fatal(err)
+			}
+			exp := make([]bool, n)
+			for i := range exp {
+				exp[i] = op.naive(na[i], nb[i])
+			}
+			if out := toBools(c); !equalBools(out, exp) {
+				t.Fatalf("n = %d, %s: Expected %v, got %v instead\n", n, op.name, exp, out)
+			}
+		}
+		if !equalBools(toBools(a), na) {
+			t.Fatalf("n = %d: Clone must not share storage\n", n)
+		}
+
+		for _, k := range []uint{0, 1, 5, 63, 64, 65, 130, 1000} {
+			lsh, rsh := make([]bool, n), make([]bool, n)
+			for i := range na {
+				if i+int(k) < n {
+					lsh[i+int(k)] = na[i]
+				}
+				if i-int(k) >= 0 {
+					rsh[i-int(k)] = na[i]
+				}
+			}
+			if out := toBools(a.Lsh(k)); !equalBools(out, lsh) {
+				t.Fatalf("n = %d, Lsh(%d): Expected %v, got %v instead\n", n, k, lsh, out)
+			}
+			if out := toBools(a.Rsh(k)); !equalBools(out, rsh) {
+				t.Fatalf("n = %d, Rsh(%d): Expected %v, got %v instead\n", n, k, rsh, out)
+			}
+			if out, exp := a.Lsh(k).Count(), countTrue(lsh); out != exp {
+				t.Fatalf("n = %d, Lsh(%d).Count: Expected %d, got %d instead\n", n, k, exp, out)
+			}
+		}
+
+		// FindFirst / FindNext で立っている bit を列挙する
+		got := []int{}
+		for i := a.FindFirst(); i != -1; i = a.FindNext(i) {
+			got = append(got, i)
+		}
+		exp := []int{}
+		for i, v := range na {
+			if v {
+				exp = append(exp, i)
+			}
+		}
+		if len(got) != len(exp) || a.Count() != len(exp) {
+			t.Fatalf("n = %d: Expected %v, got %v (count %d) instead\n", n, exp, got, a.Count())
+		}
+		for i := range got {
+			if got[i] != exp[i] {
+				t.Fatalf("n = %d: Expected %v, got %v instead\n", n, exp, got)
+			}
+		}
+	}
+}
+
+func countTrue(s []bool) int {
+	c := 0
+	for _, v := range s {
+		if v {
+			c++
+		}
+	}
+	return c
+}
+
+func TestBitsetSubsetSum(t *testing.T) {
+	// bitset DP による部分和問題
+	weights := []int{3, 5, 7, 11}
+	dp, _ := bits.New(30)
+	dp.Set(0)
+	for _, w := range weights {
+		dp.Or(dp.Lsh(uint(w)))
+	}
+	exp := "100101011011101110110101001000"
+	if out := dp.String(); out != exp {
+		t.Errorf("Expected %s, got %s instead\n", exp, out)
+	}
+}
+
+func TestBitsetErrors(t *testing.T) {
+	if _, err := bits.New(-1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	b, _ := bits.New(10)
+	if err := b.Set(10); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+	}
+	if _, err := b.Test(-1); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+	}
+	b.Set(3)
+	b.Flip(4)
+	b.Flip(3)
+	b.Reset(9)
+	if b.String() != "0000100000" {
+		t.Errorf("Expected 0000100000, got %s instead\n", b)
+	}
+	other, _ := bits.New(11)
+	if err := b.Or(other); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if out := b.FindNext(4); out != -1 {
+		t.Errorf("Expected -1, got %d instead\n", out)
+	}
+	if out := b.FindNext(-5); out != 4 {
+		t.Errorf("Expected 4, got %d instead\n", out)
+	}
+	// i + 1 がオーバーフローする場合も 先頭から探索し直さない
+	if out := b.FindNext(stdmath.MaxInt); out != -1 {
+		t.Errorf("Expected -1, got %d instead\n", out)
+	}
+	empty, _ := bits.New(0)
+	if out := empty.FindNext(-5); out != -1 {
+		t.Errorf("Expected -1, got %d instead\n", out)
+	}
+}
@@ -0,0 +1,176 @@
+package deque
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+const minCapacity = 8
+
+// Deque は 可変長のリングバッファによる 両端キューである.
+// ゼロ値は空の Deque として利用できる.
+type Deque[T any] struct {
+	buf        []T
+	head, size int
+}
+
+// New は 少なくとも capacity 個の要素を再確保なしで保持できる 空の Deque[T] を返す.
+// Time: O(capacity)
+func New[T any](capacity int) *Deque[T] {
+	c := minCapacity
+	for c < capacity {
+		c <<= 1
+	}
+	return &Deque[T]{buf: make([]T, c)}
+}
+
+// Len は 呼び出し時点での要素数を返す
+// Time: O(1)
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// PushFront は 先頭に value 値を加える.
+// Time: O(1) (amortized)
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+	d.head = (d.head - 1) & (len(d.buf) - 1)
+	d.buf[d.head] = value
+	d.size++
+}
+
+// PushBack は 末尾に value 値を加える.
+// Time: O(1) (amortized)
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+	d.buf[d.index(d.size)] = value
+	d.size++
+}
+
+// PopFront は 先頭の要素を取り除き, その値と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1) (amortized)
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if d.size == 0 {
+		return zero, errors.ErrNotFound
+	}
+	res := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return res, nil
+}
+
+// PopBack は 末尾の要素を取り除き, その値と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1) (amortized)
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if d.size == 0 {
+		return zero, errors.ErrNotFound
+	}
+	i := d.index(d.size - 1)
+	res := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	d.shrink()
+	return res, nil
+}
+
+// Front は 先頭の要素と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (d *Deque[T]) Front() (T, error) {
+	if d.size == 0 {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	return d.buf[d.head], nil
+}
+
+// Back は 末尾の要素と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (d *Deque[T]) Back() (T, error) {
+	if d.size == 0 {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	return d.buf[d.index(d.size-1)], nil
+}
+
+// At は 先頭から k(0-index) 番目の要素と error 値 nil を返す.
+// 負のインデックス値は末尾からの位置として扱われる.
+// 与インデックス値は [-Len, Len) の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidIndex が error 値として返される．
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (d *Deque[T]) At(k int) (T, error) {
+	if k < 0 {
+		k += d.size
+	}
+	if k < 0 || k >= d.size {
+		var zero T
+		return zero, errors.ErrInvalidIndex
+	}
+	return d.buf[d.index(k)], nil
+}
+
+// Set は 先頭から k(0-index) 番目の要素を value に変更する.
+// 与インデックス値に対する条件は At と同様であり, 守られない場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (d *Deque[T]) Set(k int, value T) error {
+	if k < 0 {
+		k += d.size
+	}
+	if k < 0 || k >= d.size {
+		return errors.ErrInvalidIndex
+	}
+	d.buf[d.index(k)] = value
+	return nil
+}
+
+// Clear は 全要素を削除する
+// Time: O(N)
+func (d *Deque[T]) Clear() {
+	d.buf = make([]T, minCapacity)
+	d.head, d.size = 0, 0
+}
+
+func (d *Deque[T]) index(k int) int {
+	return (d.head + k) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) grow() {
+	if len(d.buf) == 0 {
+		d.buf = make([]T, minCapacity)
+		return
+	}
+	if d.size < len(d.buf) {
+		return
+	}
+	d.resize(len(d.buf) << 1)
+}
+
+// shrink は 要素数がバッファの 1/4 以下になった場合にバッファを縮小する
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCapacity && d.size <= len(d.buf)>>2 {
+		d.resize(len(d.buf) >> 1)
+	}
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if d.head+d.size <= len(d.buf) {
+		copy(buf, d.buf[d.head:d.head+d.size])
+	} else {
+		n := copy(buf, d.buf[d.head:])
+		copy(buf[n:], d.buf[:d.size-n])
+	}
+	d.buf, d.head = buf, 0
+}
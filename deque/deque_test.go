@@ -0,0 +1,205 @@
+package deque_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	deque "github.com/hiden2000/go_ds/deque"
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestDeque(t *testing.T) {
+	const q = 5000
+	rng := rand.New(rand.NewSource(1))
+	d := deque.New[int](0)
+	naive := []int{}
+
+	for i := 0; i < q; i++ {
+		switch rng.Intn(6) {
+		case 0, 1:
+			d.PushBack(i)
+			naive = append(naive, i)
+		case 2:
+			d.PushFront(i)
+			naive = append([]int{i}, naive...)
+		case 3:
+			get, err := d.PopFront()
+			if len(naive) == 0 {
+				if err != errors.ErrNotFound {
+					t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			} else if get != naive[0] {
+				t.Fatalf("Expected %d, got %d instead.", naive[0], get)
+			}
+			naive = naive[1:]
+		case 4:
+			get, err := d.PopBack()
+			if len(naive) == 0 {
+				if err != errors.ErrNotFound {
+					t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			} else if get != naive[len(naive)-1] {
+				t.Fatalf("Expected %d, got %d instead.", naive[len(naive)-1], get)
+			}
+			naive = naive[:len(naive)-1]
+		case 5:
+			if len(naive) == 0 {
+				continue
+			}
+			k := rng.Intn(len(naive))
+			if err := d.Set(k, -i); err != nil {
+				t.Fatal(err)
+			}
+			naive[k] = -i
+		}
+
+		if d.Len() != len(naive) {
+			t.Fatalf("Expected %d, got %d instead.", len(naive), d.Len())
+		}
+		for k := range naive {
+			if get, err := d.At(k); err != nil {
+				t.Fatal(err)
+			} else if get != naive[k] {
+				t.Fatalf("At(%d): Expected %d, got %d instead.", k, naive[k], get)
+			}
+		}
+	}
+}
+
+func TestAt(t *testing.T) {
+	var d deque.Deque[string]
+	for _, s := range []string{"b", "c", "d"} {
+		d.PushBack(s)
+	}
+	d.PushFront("a")
+
+	testCases := []struct {
+		name  string
+		index int
+		exp   string
+		err   error
+	}{
+		{name: "First", index: 0, exp: "a"},
+		{name: "Last", index: 3, exp: "d"},
+		{name: "NegativeLast", index: -1, exp: "d"},
+		{name: "NegativeFirst", index: -4, exp: "a"},
+		{name: "TooLarge", index: 4, err: errors.ErrInvalidIndex},
+		{name: "TooSmall", index: -5, err: errors.ErrInvalidIndex},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			get, err := d.At(tc.index)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead.", tc.err, err)
+			}
+			if err == nil && get != tc.exp {
+				t.Errorf("Expected %s, got %s instead.", tc.exp, get)
+			}
+		})
+	}
+
+	if front, err := d.Front(); err != nil || front != "a" {
+		t.Errorf("Expected %s, got %s (%v) instead.", "a", front, err)
+	}
+	if back, err := d.Back(); err != nil || back != "d" {
+		t.Errorf("Expected %s, got %s (%v) instead.", "d", back, err)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		args   []int
+		k      int
+		expMin []int
+		expMax []int
+		err    error
+	}{
+		{
+			name:   "Window1",
+			args:   []int{3, 1, 4},
+			k:      1,
+			expMin: []int{3, 1, 4},
+			expMax: []int{3, 1, 4},
+		},
+		{
+			name:   "Window3",
+			args:   []int{1, 3, -1, -3, 5, 3, 6, 7},
+			k:      3,
+			expMin: []int{-1, -3, -3, -3, 3, 3},
+			expMax: []int{3, 3, 5, 5, 6, 7},
+		},
+		{
+			name:   "AllSame",
+			args:   []int{2, 2, 2, 2},
+			k:      2,
+			expMin: []int{2, 2, 2},
+			expMax: []int{2, 2, 2},
+		},
+		{
+			name: "TooLarge",
+			args: []int{1, 2},
+			k:    3,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "Zero",
+			args: []int{1, 2},
+			k:    0,
+			err:  errors.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mins, err := deque.SlidingWindow(tc.args, tc.k, func(left, right int) bool { return left < right })
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead.", tc.err, err)
+			}
+			maxs, _ := deque.SlidingWindow(tc.args, tc.k, func(left, right int) bool { return left > right })
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(mins, tc.expMin) {
+				t.Errorf("Min: Expected %v, got %v instead.", tc.expMin, mins)
+			}
+			if !reflect.DeepEqual(maxs, tc.expMax) {
+				t.Errorf("Max: Expected %v, got %v instead.", tc.expMax, maxs)
+			}
+		})
+	}
+}
+
+func TestMonotonicQueue(t *testing.T) {
+	q := deque.NewMonotonic(func(left, right int) bool { return left < right })
+	if _, err := q.Top(); err != errors.ErrNotFound {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+	}
+	if err := q.Pop(); err != errors.ErrNotFound {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+	}
+	for _, v := range []int{5, 2, 7, 2, 9} {
+		q.Push(v)
+	}
+	for _, exp := range []int{2, 2, 2, 2, 9} {
+		if top, err := q.Top(); err != nil || top != exp {
+			t.Errorf("Expected %d, got %d (%v) instead.", exp, top, err)
+		}
+		if err := q.Pop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("Expected %d, got %d instead.", 0, q.Len())
+	}
+}
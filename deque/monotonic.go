@@ -0,0 +1,104 @@
+package deque
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// OrderableFunc は 要素の大小順序を決定する関数である.
+type OrderableFunc[T any] func(left, right T) bool
+
+type indexed[T any] struct {
+	index int
+	value T
+}
+
+// MonotonicQueue は 先入れ先出しのキューのうち, 最も優先される要素を O(1) で取得できる単調キューである.
+// スライド最小値・スライド最大値の計算に用いる.
+type MonotonicQueue[T any] struct {
+	dq             Deque[indexed[T]]
+	op             OrderableFunc[T]
+	pushed, popped int
+}
+
+// NewMonotonic は 要素の大小順序を定義した関数 OrderableFunc[T] を引数にとり, 空の MonotonicQueue[T] を返す.
+// op(a, b) が true のとき a は b より優先される. 例えば op が '<' であれば Top は最小値を返す.
+// Time: O(1)
+func NewMonotonic[T any](operator OrderableFunc[T]) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{op: operator}
+}
+
+// Len は 呼び出し時点での要素数を返す
+// Time: O(1)
+func (q *MonotonicQueue[T]) Len() int {
+	return q.pushed - q.popped
+}
+
+// Push は 末尾に value 値を加える.
+// Time: O(1) (amortized)
+func (q *MonotonicQueue[T]) Push(value T) {
+	for q.dq.Len() > 0 {
+		back, _ := q.dq.Back()
+		if q.op(back.value, value) {
+			break
+		}
+		_, _ = q.dq.PopBack()
+	}
+	q.dq.PushBack(indexed[T]{index: q.pushed, value: value})
+	q.pushed++
+}
+
+// Pop は 先頭 (最も古い) の要素を取り除く.
+// 要素がない場合は ErrNotFound が error 値として返され,操作は棄却される.
+// Time: O(1) (amortized)
+func (q *MonotonicQueue[T]) Pop() error {
+	if q.Len() == 0 {
+		return errors.ErrNotFound
+	}
+	if front, _ := q.dq.Front(); front.index == q.popped {
+		_, _ = q.dq.PopFront()
+	}
+	q.popped++
+	return nil
+}
+
+// Top は キューに含まれる要素のうち最も優先される値と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (q *MonotonicQueue[T]) Top() (T, error) {
+	if q.Len() == 0 {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	front, _ := q.dq.Front()
+	return front.value, nil
+}
+
+// SlidingWindow は values の長さ k の各連続部分列 values[i:i+k] について 最も優先される値を求め,
+// それらを i の昇順に並べたスライスと error 値 nil を返す.
+// k は [1, len(values)] の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N)
+func SlidingWindow[T any](values []T, k int, operator OrderableFunc[T]) ([]T, error) {
+	if k <= 0 || k > len(values) {
+		return nil, errors.ErrInvalidValue
+	}
+	q := NewMonotonic(operator)
+	res := make([]T, 0, len(values)-k+1)
+	for i, v := range values {
+		q.Push(v)
+		if i >= k {
+			if err := q.Pop(); err != nil {
+				return nil, err
+			}
+		}
+		if i >= k-1 {
+			top, err := q.Top()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, top)
+		}
+	}
+	return res, nil
+}
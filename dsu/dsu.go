@@ -0,0 +1,100 @@
+package dsu
+
+// DSU は union by size と経路圧縮を備えた素集合データ構造 (Union-Find) である.
+type DSU struct {
+	// parent[x] < 0 のとき x は根であり, -parent[x] がその集合の要素数を表す
+	parent []int
+	groups int
+}
+
+// New は 要素 0, 1, ..., n-1 がそれぞれ独立した集合をなす DSU を返す.
+// Time: O(N)
+func New(n int) *DSU {
+	d := &DSU{
+		parent: make([]int, n),
+		groups: n,
+	}
+	for i := range d.parent {
+		d.parent[i] = -1
+	}
+	return d
+}
+
+// Len は 管理している要素数を返す
+// Time: O(1)
+func (d *DSU) Len() int {
+	return len(d.parent)
+}
+
+// Count は 呼び出し時点での集合の個数を返す
+// Time: O(1)
+func (d *DSU) Count() int {
+	return d.groups
+}
+
+// Find は 要素 x が属する集合の代表元を返す.
+// x は [0, N) の範囲になくてはならない.
+// Time: O(α(N)) (amortized)
+func (d *DSU) Find(x int) int {
+	root := x
+	for d.parent[root] >= 0 {
+		root = d.parent[root]
+	}
+	for d.parent[x] >= 0 {
+		x, d.parent[x] = d.parent[x], root
+	}
+	return root
+}
+
+// Union は 要素 a と要素 b が属する集合を併合する.
+// 併合が行われた場合は true を, 既に同じ集合に属していた場合は false を返す.
+// Time: O(α(N)) (amortized)
+func (d *DSU) Union(a, b int) bool {
+	a, b = d.Find(a), d.Find(b)
+	if a == b {
+		return false
+	}
+	if d.parent[a] > d.parent[b] {
+		a, b = b, a
+	}
+	d.parent[a] += d.parent[b]
+	d.parent[b] = a
+	d.groups--
+	return true
+}
+
+// Same は 要素 a と要素 b が同じ集合に属するかを判定する
+// Time: O(α(N)) (amortized)
+func (d *DSU) Same(a, b int) bool {
+	return d.Find(a) == d.Find(b)
+}
+
+// Size は 要素 x が属する集合の要素数を返す
+// Time: O(α(N)) (amortized)
+func (d *DSU) Size(x int) int {
+	return -d.parent[d.Find(x)]
+}
+
+// Groups は 各集合に属する要素を昇順に並べたスライスの一覧を返す.
+// 集合の並び順は 各集合の最小要素の昇順である.
+// Time: O(N α(N))
+func (d *DSU) Groups() [][]int {
+	return groups(len(d.parent), d.Find)
+}
+
+func groups(n int, find func(int) int) [][]int {
+	index := make([]int, n)
+	for i := range index {
+		index[i] = -1
+	}
+	res := [][]int{}
+	for x := 0; x < n; x++ {
+		root := find(x)
+		if index[root] < 0 {
+			index[root] = len(res)
+			res = append(res, []int{})
+		}
+		res[index[root]] = append(res[index[root]], x)
+	}
+	return res
+}
@@ -0,0 +1,270 @@
+package dsu_test
+
+import (
+	stdmath "math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	dsu "github.com/hiden2000/go_ds/dsu"
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// naiveGroups は ラベル配列を用いて素朴に連結成分を管理する
+type naiveGroups []int
+
+func newNaive(n int) naiveGroups {
+	label := make(naiveGroups, n)
+	for i := range label {
+		label[i] = i
+	}
+	return label
+}
+
+func (g naiveGroups) union(a, b int) {
+	from, to := g[b], g[a]
+	for i := range g {
+		if g[i] == from {
+			g[i] = to
+		}
+	}
+}
+
+func (g naiveGroups) size(x int) int {
+	res := 0
+	for i := range g {
+		if g[i] == g[x] {
+			res++
+		}
+	}
+	return res
+}
+
+func TestUnionFind(t *testing.T) {
+	testCases := []struct {
+		name  string
+		n     int
+		edges [][2]int
+		exp   [][]int
+	}{
+		{
+			name: "NoEdge",
+			n:    3,
+			exp:  [][]int{{0}, {1}, {2}},
+		},
+		{
+			name:  "Chain",
+			n:     5,
+			edges: [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}},
+			exp:   [][]int{{0, 1, 2, 3, 4}},
+		},
+		{
+			name:  "TwoGroups",
+			n:     6,
+			edges: [][2]int{{5, 3}, {0, 2}, {3, 1}, {2, 4}, {4, 0}},
+			exp:   [][]int{{0, 2, 4}, {1, 3, 5}},
+		},
+		{
+			name:  "SelfLoop",
+			n:     2,
+			edges: [][2]int{{1, 1}, {0, 0}},
+			exp:   [][]int{{0}, {1}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := dsu.New(tc.n)
+			for _, e := range tc.edges {
+				d.Union(e[0], e[1])
+			}
+			if groups := d.Groups(); !reflect.DeepEqual(groups, tc.exp) {
+				t.Errorf("Expected %v, got %v instead.", tc.exp, groups)
+			}
+			if d.Count() != len(tc.exp) {
+				t.Errorf("Expected %d, got %d instead.", len(tc.exp), d.Count())
+			}
+			for _, g := range tc.exp {
+				for _, x := range g {
+					if !d.Same(g[0], x) {
+						t.Errorf("%d and %d should be in the same group.", g[0], x)
+					}
+					if d.Size(x) != len(g) {
+						t.Errorf("Size(%d): Expected %d, got %d instead.", x, len(g), d.Size(x))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestUnionFindRandom(t *testing.T) {
+	const n, q = 50, 500
+	rng := rand.New(rand.NewSource(1))
+	d, naive := dsu.New(n), newNaive(n)
+	for i := 0; i < q; i++ {
+		a, b := rng.Intn(n), rng.Intn(n)
+		merged := d.Union(a, b)
+		if merged == (naive[a] == naive[b]) {
+			t.Fatalf("Union(%d, %d): unexpected result %v", a, b, merged)
+		}
+		naive.union(a, b)
+		x, y := rng.Intn(n), rng.Intn(n)
+		if d.Same(x, y) != (naive[x] == naive[y]) {
+			t.Fatalf("Same(%d, %d): unexpected result", x, y)
+		}
+		if d.Size(x) != naive.size(x) {
+			t.Fatalf("Size(%d): Expected %d, got %d instead.", x, naive.size(x), d.Size(x))
+		}
+	}
+}
+
+func TestRollback(t *testing.T) {
+	d := dsu.NewRollback(5)
+	d.Union(0, 1)
+	state := d.Snapshot()
+	d.Union(2, 3)
+	d.Union(1, 3)
+	if d.Union(0, 2) {
+		t.Errorf("0 and 2 should already be in the same group.")
+	}
+	if d.Size(0) != 4 || d.Count() != 2 {
+		t.Errorf("Expected (4, 2), got (%d, %d) instead.", d.Size(0), d.Count())
+	}
+
+	if err := d.Rollback(state + 100); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrInvalidIndex, err)
+	}
+	if err := d.Rollback(state); err != nil {
+		t.Fatal(err)
+	}
+	if exp, groups := [][]int{{0, 1}, {2}, {3}, {4}}, d.Groups(); !reflect.DeepEqual(groups, exp) {
+		t.Errorf("Expected %v, got %v instead.", exp, groups)
+	}
+
+	if err := d.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if d.Same(0, 1) || d.Count() != 5 {
+		t.Errorf("All unions should be undone.")
+	}
+	if err := d.Undo(); err != errors.ErrNotFound {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+	}
+}
+
+func TestRollbackRandom(t *testing.T) {
+	const n, q = 30, 300
+	rng := rand.New(rand.NewSource(2))
+	d := dsu.NewRollback(n)
+	states := []int{}
+	labels := []naiveGroups{}
+	naive := newNaive(n)
+	for i := 0; i < q; i++ {
+		switch {
+		case rng.Intn(4) == 0:
+			states = append(states, d.Snapshot())
+			labels = append(labels, append(naiveGroups{}, naive...))
+		case rng.Intn(3) == 0 && len(states) > 0:
+			if err := d.Rollback(states[len(states)-1]); err != nil {
+				t.Fatal(err)
+			}
+			naive = labels[len(labels)-1]
+			states, labels = states[:len(states)-1], labels[:len(labels)-1]
+		default:
+			a, b := rng.Intn(n), rng.Intn(n)
+			d.Union(a, b)
+			naive.union(a, b)
+		}
+		for x := 0; x < n; x++ {
+			if d.Size(x) != naive.size(x) {
+				t.Fatalf("Size(%d): Expected %d, got %d instead.", x, naive.size(x), d.Size(x))
+			}
+		}
+	}
+}
+
+func TestWeighted(t *testing.T) {
+	d := dsu.NewWeighted[int](5)
+	if err := d.Union(0, 1, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Union(2, 1, -2); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Union(3, 4, 10); err != nil {
+		t.Fatal(err)
+	}
+	// 0 -> 1 : +3, 2 -> 1 : -2 より 0 -> 2 : +5
+	if w, err := d.Diff(0, 2); err != nil {
+		t.Fatal(err)
+	} else if w != 5 {
+		t.Errorf("Expected %d, got %d instead.", 5, w)
+	}
+	if _, err := d.Diff(0, 4); err != errors.ErrNotFound {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+	}
+	if err := d.Union(0, 2, 5); err != nil {
+		t.Errorf("Consistent constraint should be accepted: %v", err)
+	}
+	if err := d.Union(0, 2, 4); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrInvalidValue, err)
+	}
+	if err := d.Union(4, 2, 1); err != nil {
+		t.Fatal(err)
+	}
+	// 3 -> 4 : +10, 4 -> 2 : +1, 2 -> 0 : -5
+	if w, err := d.Diff(3, 0); err != nil {
+		t.Fatal(err)
+	} else if w != 6 {
+		t.Errorf("Expected %d, got %d instead.", 6, w)
+	}
+}
+
+func TestWeightedFloat(t *testing.T) {
+	// 0 -> 1 : +0.1, 1 -> 2 : +0.2 に対し 0 -> 2 : +0.3 は浮動小数点数として厳密には一致しない
+	exact := dsu.NewWeighted[float64](3)
+	approx := dsu.NewWeightedWithEqual(3, func(a, b float64) bool { return stdmath.Abs(a-b) <= 1e-9 })
+	for _, d := range []*dsu.WeightedDSU[float64]{exact, approx} {
+		if err := d.Union(0, 1, 0.1); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.Union(1, 2, 0.2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := exact.Union(0, 2, 0.3); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrInvalidValue, err)
+	}
+	if err := approx.Union(0, 2, 0.3); err != nil {
+		t.Errorf("Consistent constraint should be accepted: %v", err)
+	}
+	if err := approx.Union(0, 2, 0.4); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestWeightedRandom(t *testing.T) {
+	const n, q = 40, 400
+	rng := rand.New(rand.NewSource(3))
+	potential := make([]int64, n)
+	for i := range potential {
+		potential[i] = rng.Int63n(1000) - 500
+	}
+	d := dsu.NewWeighted[int64](n)
+	for i := 0; i < q; i++ {
+		a, b := rng.Intn(n), rng.Intn(n)
+		if err := d.Union(a, b, potential[b]-potential[a]); err != nil {
+			t.Fatal(err)
+		}
+		if d.Same(a, b) && a != b {
+			if err := d.Union(a, b, potential[b]-potential[a]+1); err != errors.ErrInvalidValue {
+				t.Fatalf("Expected %v, got %v instead.", errors.ErrInvalidValue, err)
+			}
+		}
+		x, y := rng.Intn(n), rng.Intn(n)
+		if w, err := d.Diff(x, y); err == nil && w != potential[y]-potential[x] {
+			t.Fatalf("Diff(%d, %d): Expected %d, got %d instead.", x, y, potential[y]-potential[x], w)
+		}
+	}
+}
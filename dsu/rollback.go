@@ -0,0 +1,132 @@
+package dsu
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+type history struct {
+	a, b, parentA int
+}
+
+// RollbackDSU は 経路圧縮を行わない代わりに 併合操作の巻き戻しが可能な素集合データ構造である.
+// オフラインでの動的連結性判定 (Offline Dynamic Connectivity) 等に用いる.
+type RollbackDSU struct {
+	parent  []int
+	groups  int
+	history []history
+}
+
+// NewRollback は 要素 0, 1, ..., n-1 がそれぞれ独立した集合をなす RollbackDSU を返す.
+// Time: O(N)
+func NewRollback(n int) *RollbackDSU {
+	d := &RollbackDSU{
+		parent:  make([]int, n),
+		groups:  n,
+		history: []history{},
+	}
+	for i := range d.parent {
+		d.parent[i] = -1
+	}
+	return d
+}
+
+// Len は 管理している要素数を返す
+// Time: O(1)
+func (d *RollbackDSU) Len() int {
+	return len(d.parent)
+}
+
+// Count は 呼び出し時点での集合の個数を返す
+// Time: O(1)
+func (d *RollbackDSU) Count() int {
+	return d.groups
+}
+
+// Find は 要素 x が属する集合の代表元を返す.
+// x は [0, N) の範囲になくてはならない.
+// Time: O(log N)
+func (d *RollbackDSU) Find(x int) int {
+	for d.parent[x] >= 0 {
+		x = d.parent[x]
+	}
+	return x
+}
+
+// Union は 要素 a と要素 b が属する集合を併合する.
+// 併合が行われた場合は true を, 既に同じ集合に属していた場合は false を返す.
+// いずれの場合も操作は履歴に積まれ, Undo によって 1 回分として取り消される.
+// Time: O(log N)
+func (d *RollbackDSU) Union(a, b int) bool {
+	a, b = d.Find(a), d.Find(b)
+	if d.parent[a] > d.parent[b] {
+		a, b = b, a
+	}
+	d.history = append(d.history, history{a: a, b: b, parentA: d.parent[a]})
+	if a == b {
+		return false
+	}
+	d.parent[a] += d.parent[b]
+	d.parent[b] = a
+	d.groups--
+	return true
+}
+
+// Same は 要素 a と要素 b が同じ集合に属するかを判定する
+// Time: O(log N)
+func (d *RollbackDSU) Same(a, b int) bool {
+	return d.Find(a) == d.Find(b)
+}
+
+// Size は 要素 x が属する集合の要素数を返す
+// Time: O(log N)
+func (d *RollbackDSU) Size(x int) int {
+	return -d.parent[d.Find(x)]
+}
+
+// Groups は 各集合に属する要素を昇順に並べたスライスの一覧を返す.
+// 集合の並び順は 各集合の最小要素の昇順である.
+// Time: O(N log N)
+func (d *RollbackDSU) Groups() [][]int {
+	return groups(len(d.parent), d.Find)
+}
+
+// Undo は 直前の Union 操作を 1 回分取り消す.
+// 取り消す操作がない場合は ErrNotFound が error 値として返される.
+// Time: O(1)
+func (d *RollbackDSU) Undo() error {
+	if len(d.history) == 0 {
+		return errors.ErrNotFound
+	}
+	h := d.history[len(d.history)-1]
+	d.history = d.history[:len(d.history)-1]
+	if h.a == h.b {
+		return nil
+	}
+	d.parent[h.b] = d.parent[h.a] - h.parentA
+	d.parent[h.a] = h.parentA
+	d.groups++
+	return nil
+}
+
+// Snapshot は 呼び出し時点の状態を表す値を返す.
+// 返り値を Rollback に渡すことで その時点の状態へ戻すことができる.
+// Time: O(1)
+func (d *RollbackDSU) Snapshot() int {
+	return len(d.history)
+}
+
+// Rollback は Snapshot により得られた state の時点まで Union 操作を取り消す.
+// state は [0, 現在の Snapshot の値] の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidIndex が error 値として返され,操作は棄却される.
+// Time: O(取り消す操作の回数)
+func (d *RollbackDSU) Rollback(state int) error {
+	if state < 0 || state > len(d.history) {
+		return errors.ErrInvalidIndex
+	}
+	for len(d.history) > state {
+		if err := d.Undo(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package dsu
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+)
+
+// Number は WeightedDSU が扱うことのできるポテンシャルの型である.
+type Number interface {
+	math.Ints | math.Floats
+}
+
+// WeightedDSU は 各要素にポテンシャルを持たせ,
+// 同じ集合に属する要素間のポテンシャルの差を管理する素集合データ構造である.
+type WeightedDSU[T Number] struct {
+	parent []int
+	// diff[x] は (x のポテンシャル) - (parent[x] のポテンシャル) を表す
+	diff   []T
+	groups int
+	equal  func(a, b T) bool
+}
+
+// NewWeighted は 要素 0, 1, ..., n-1 がそれぞれ独立した集合をなす WeightedDSU を返す.
+// Union における制約の矛盾は ポテンシャルの差が == で等しいかにより判定する.
+// T が浮動小数点数型の場合は 丸め誤差により矛盾と判定されうるため, NewWeightedWithEqual で許容誤差を指定する.
+// Time: O(N)
+func NewWeighted[T Number](n int) *WeightedDSU[T] {
+	return NewWeightedWithEqual(n, func(a, b T) bool { return a == b })
+}
+
+// NewWeightedWithEqual は 要素 0, 1, ..., n-1 がそれぞれ独立した集合をなし,
+// Union における制約の矛盾を ポテンシャルの差が equal で等しいとみなされるかにより判定する WeightedDSU を返す.
+// Time: O(N)
+func NewWeightedWithEqual[T Number](n int, equal func(a, b T) bool) *WeightedDSU[T] {
+	d := &WeightedDSU[T]{
+		parent: make([]int, n),
+		diff:   make([]T, n),
+		groups: n,
+		equal:  equal,
+	}
+	for i := range d.parent {
+		d.parent[i] = -1
+	}
+	return d
+}
+
+// Len は 管理している要素数を返す
+// Time: O(1)
+func (d *WeightedDSU[T]) Len() int {
+	return len(d.parent)
+}
+
+// Count は 呼び出し時点での集合の個数を返す
+// Time: O(1)
+func (d *WeightedDSU[T]) Count() int {
+	return d.groups
+}
+
+// Find は 要素 x が属する集合の代表元を返す.
+// x は [0, N) の範囲になくてはならない.
+// Time: O(α(N)) (amortized)
+func (d *WeightedDSU[T]) Find(x int) int {
+	root, _ := d.find(x)
+	return root
+}
+
+// Weight は 要素 x のポテンシャルから x が属する集合の代表元のポテンシャルを引いた値を返す
+// Time: O(α(N)) (amortized)
+func (d *WeightedDSU[T]) Weight(x int) T {
+	_, w := d.find(x)
+	return w
+}
+
+// Union は (b のポテンシャル) - (a のポテンシャル) = w という制約を加え, a と b が属する集合を併合する.
+// a と b が既に同じ集合に属しており, 既存の制約と矛盾する場合は ErrInvalidValue が error 値として返され,操作は棄却される.
+// 既存の制約と矛盾しない場合は nil が error 値として返される.
+// Time: O(α(N)) (amortized)
+func (d *WeightedDSU[T]) Union(a, b int, w T) error {
+	ra, wa := d.find(a)
+	rb, wb := d.find(b)
+	if ra == rb {
+		if !d.equal(wb-wa, w) {
+			return errors.ErrInvalidValue
+		}
+		return nil
+	}
+	// (rb のポテンシャル) - (ra のポテンシャル)
+	w = w + wa - wb
+	if d.parent[ra] > d.parent[rb] {
+		ra, rb, w = rb, ra, -w
+	}
+	d.parent[ra] += d.parent[rb]
+	d.parent[rb] = ra
+	d.diff[rb] = w
+	d.groups--
+	return nil
+}
+
+// Diff は (b のポテンシャル) - (a のポテンシャル) と error 値 nil を返す.
+// a と b が異なる集合に属する場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(α(N)) (amortized)
+func (d *WeightedDSU[T]) Diff(a, b int) (T, error) {
+	ra, wa := d.find(a)
+	rb, wb := d.find(b)
+	if ra != rb {
+		return 0, errors.ErrNotFound
+	}
+	return wb - wa, nil
+}
+
+// Same は 要素 a と要素 b が同じ集合に属するかを判定する
+// Time: O(α(N)) (amortized)
+func (d *WeightedDSU[T]) Same(a, b int) bool {
+	return d.Find(a) == d.Find(b)
+}
+
+// Size は 要素 x が属する集合の要素数を返す
+// Time: O(α(N)) (amortized)
+func (d *WeightedDSU[T]) Size(x int) int {
+	return -d.parent[d.Find(x)]
+}
+
+// Groups は 各集合に属する要素を昇順に並べたスライスの一覧を返す.
+// 集合の並び順は 各集合の最小要素の昇順である.
+// Time: O(N α(N))
+func (d *WeightedDSU[T]) Groups() [][]int {
+	return groups(len(d.parent), d.Find)
+}
+
+func (d *WeightedDSU[T]) find(x int) (int, T) {
+	if d.parent[x] < 0 {
+		return x, 0
+	}
+	root, w := d.find(d.parent[x])
+	d.diff[x] += w
+	d.parent[x] = root
+	return root, d.diff[x]
+}
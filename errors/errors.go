@@ -6,5 +6,6 @@ var (
 	ErrInvalidIndex = errors.New("index is not valid")
 	ErrInvalidValue = errors.New("value is not valid")
 	ErrNotFound     = errors.New("value is not found")
+	ErrOverflow     = errors.New("arithmetic overflow occured")
 	ErrUnexpected   = errors.New("unexpected error occured. A bug may be in the source code")
 )
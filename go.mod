@@ -1,3 +1,3 @@
 module github.com/hiden2000/go_ds
 
-go 1.19
+go 1.21
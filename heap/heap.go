@@ -0,0 +1,127 @@
+package heap
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// OrderableFunc は 要素の大小順序を決定する関数である.
+// left が right より優先される (先に取り出される) 場合に true を返さなくてはならない.
+type OrderableFunc[T any] func(left, right T) bool
+
+// PriorityQueue は 二分ヒープによる優先度付きキューである.
+type PriorityQueue[T any] struct {
+	data []T
+	op   OrderableFunc[T]
+}
+
+// New は 要素の大小順序を定義した関数 OrderableFunc[T] を引数にとり, 空の PriorityQueue[T] を返す.
+// OrderableFunc[T] は 狭義の全順序 (strict weak ordering) でなくてはならない.
+//
+// <ex>
+// [T = int, 最小値から取り出す]
+//
+//	func op(a, b int) bool {
+//		return a < b
+//	}
+//
+// Time: O(1)
+func New[T any](operator OrderableFunc[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		data: []T{},
+		op:   operator,
+	}
+}
+
+// NewFromSlice は values の要素をすべて含む PriorityQueue[T] を返す.
+// values はコピーされ, 呼び出し元のスライスは変更されない.
+// Time: O(N)
+func NewFromSlice[T any](operator OrderableFunc[T], values []T) *PriorityQueue[T] {
+	q := &PriorityQueue[T]{
+		data: append([]T{}, values...),
+		op:   operator,
+	}
+	for i := len(q.data)/2 - 1; i >= 0; i-- {
+		q.down(i)
+	}
+	return q
+}
+
+// Len は 呼び出し時点での要素数を返す
+// Time: O(1)
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.data)
+}
+
+// Push は 渡された value 値を新たに加える.
+// Time: O(log N)
+func (q *PriorityQueue[T]) Push(value T) {
+	q.data = append(q.data, value)
+	q.up(len(q.data) - 1)
+}
+
+// Peek は 最も優先される要素と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (q *PriorityQueue[T]) Peek() (T, error) {
+	if len(q.data) == 0 {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	return q.data[0], nil
+}
+
+// Pop は 最も優先される要素を取り除き, その値と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log N)
+func (q *PriorityQueue[T]) Pop() (T, error) {
+	var zero T
+	if len(q.data) == 0 {
+		return zero, errors.ErrNotFound
+	}
+	n := len(q.data) - 1
+	res := q.data[0]
+	q.data[0] = q.data[n]
+	q.data[n] = zero
+	q.data = q.data[:n]
+	if n > 0 {
+		q.down(0)
+	}
+	return res, nil
+}
+
+// Clear は 全要素を削除する
+// Time: O(1)
+func (q *PriorityQueue[T]) Clear() {
+	q.data = []T{}
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if !q.op(q.data[i], q.data[p]) {
+			break
+		}
+		q.data[i], q.data[p] = q.data[p], q.data[i]
+		i = p
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) {
+	n := len(q.data)
+	for {
+		best, l, r := i, 2*i+1, 2*i+2
+		if l < n && q.op(q.data[l], q.data[best]) {
+			best = l
+		}
+		if r < n && q.op(q.data[r], q.data[best]) {
+			best = r
+		}
+		if best == i {
+			return
+		}
+		q.data[i], q.data[best] = q.data[best], q.data[i]
+		i = best
+	}
+}
@@ -0,0 +1,206 @@
+package heap_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	heap "github.com/hiden2000/go_ds/heap"
+)
+
+func TestPushPop(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []int
+	}{
+		{
+			name: "AllSame",
+			args: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name: "AllUnique",
+			args: []int{3, 1, 4, 5, 9, 2, 6, 8, 7, 0},
+		},
+		{
+			name: "NoElement",
+		},
+		{
+			name: "Random",
+			args: []int{390841, -276234, -58866, 279117, -377391, -507712, 95271, 853932, 582680, -539932},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted := append([]int{}, tc.args...)
+			sort.Ints(sorted)
+
+			queues := map[string]*heap.PriorityQueue[int]{
+				"Push":      heap.New(func(left, right int) bool { return left < right }),
+				"FromSlice": heap.NewFromSlice(func(left, right int) bool { return left < right }, tc.args),
+			}
+			for _, v := range tc.args {
+				queues["Push"].Push(v)
+			}
+
+			for name, q := range queues {
+				if q.Len() != len(tc.args) {
+					t.Errorf("%s: Expected %d, got %d instead.", name, len(tc.args), q.Len())
+				}
+				for _, exp := range sorted {
+					if top, err := q.Peek(); err != nil {
+						t.Fatal(err)
+					} else if top != exp {
+						t.Errorf("%s: Expected %d, got %d instead.", name, exp, top)
+					}
+					if get, err := q.Pop(); err != nil {
+						t.Fatal(err)
+					} else if get != exp {
+						t.Errorf("%s: Expected %d, got %d instead.", name, exp, get)
+					}
+				}
+				if _, err := q.Pop(); err != errors.ErrNotFound {
+					t.Errorf("%s: Expected %v, got %v instead.", name, errors.ErrNotFound, err)
+				}
+				if _, err := q.Peek(); err != errors.ErrNotFound {
+					t.Errorf("%s: Expected %v, got %v instead.", name, errors.ErrNotFound, err)
+				}
+			}
+		})
+	}
+}
+
+func TestIndexed(t *testing.T) {
+	const n, q = 100, 2000
+	rng := rand.New(rand.NewSource(1))
+	pq := heap.NewIndexed(func(left, right int) bool { return left < right })
+	naive := map[heap.Handle]int{}
+
+	for i := 0; i < q; i++ {
+		switch rng.Intn(4) {
+		case 0:
+			v := rng.Intn(n)
+			naive[pq.Push(v)] = v
+		case 1:
+			h := heap.Handle(rng.Intn(i + 1))
+			v := rng.Intn(n)
+			err := pq.Update(h, v)
+			if _, ok := naive[h]; ok {
+				if err != nil {
+					t.Fatal(err)
+				}
+				naive[h] = v
+			} else if err != errors.ErrNotFound {
+				t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+			}
+		case 2:
+			h := heap.Handle(rng.Intn(i + 1))
+			err := pq.Remove(h)
+			if _, ok := naive[h]; ok {
+				if err != nil {
+					t.Fatal(err)
+				}
+				delete(naive, h)
+			} else if err != errors.ErrNotFound {
+				t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+			}
+		case 3:
+			h, v, err := pq.Pop()
+			if len(naive) == 0 {
+				if err != errors.ErrNotFound {
+					t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, w := range naive {
+				if w < v {
+					t.Fatalf("Popped %d, but %d is still in the queue.", v, w)
+				}
+			}
+			if naive[h] != v {
+				t.Fatalf("Expected %d, got %d instead.", naive[h], v)
+			}
+			delete(naive, h)
+		}
+		if pq.Len() != len(naive) {
+			t.Fatalf("Expected %d, got %d instead.", len(naive), pq.Len())
+		}
+		for h, v := range naive {
+			if get, err := pq.Get(h); err != nil || get != v {
+				t.Fatalf("Get(%d): Expected %d, got %d (%v) instead.", h, v, get, err)
+			}
+		}
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	// (from, to, cost)
+	edges := [][3]int{{0, 1, 7}, {0, 2, 9}, {0, 5, 14}, {1, 2, 10}, {1, 3, 15}, {2, 3, 11}, {2, 5, 2}, {3, 4, 6}, {4, 5, 9}}
+	exp := []int{0, 7, 9, 20, 20, 11}
+
+	const inf = 1 << 60
+	graph := make([][][2]int, len(exp))
+	for _, e := range edges {
+		graph[e[0]] = append(graph[e[0]], [2]int{e[1], e[2]})
+		graph[e[1]] = append(graph[e[1]], [2]int{e[0], e[2]})
+	}
+	dist := make([]int, len(exp))
+	handles := make([]heap.Handle, len(exp))
+	pq := heap.NewIndexed(func(left, right int) bool { return dist[left] < dist[right] })
+	for v := range dist {
+		dist[v] = inf
+	}
+	dist[0] = 0
+	for v := range dist {
+		handles[v] = pq.Push(v)
+	}
+	for pq.Len() > 0 {
+		_, v, err := pq.Pop()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range graph[v] {
+			if nd := dist[v] + e[1]; nd < dist[e[0]] {
+				dist[e[0]] = nd
+				if pq.Contains(handles[e[0]]) {
+					if err := pq.Update(handles[e[0]], e[0]); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+		}
+	}
+	for v := range exp {
+		if dist[v] != exp[v] {
+			t.Errorf("Expected %d, got %d instead.", exp[v], dist[v])
+		}
+	}
+}
+
+func BenchmarkPushPop(b *testing.B) {
+
+	const nSize int = 200000
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+
+		q := heap.New(func(left, right int) bool {
+			return left < right
+		})
+
+		for j := 0; j < nSize; j++ {
+			q.Push(nSize - j)
+		}
+
+		for j := 0; j < nSize; j++ {
+			if _, err := q.Pop(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
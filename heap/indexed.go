@@ -0,0 +1,170 @@
+package heap
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Handle は IndexedPriorityQueue に加えられた要素を識別する値である.
+// Push のたびに 0 から順に払い出される.
+type Handle int
+
+// IndexedPriorityQueue は 加えた要素の値の変更 (decrease-key 等) や削除が可能な 二分ヒープによる優先度付きキューである.
+// Dijkstra 法や Prim 法での利用を想定している.
+type IndexedPriorityQueue[T any] struct {
+	heap   []Handle
+	pos    []int // pos[h] は heap 中での h の位置を表し, キューに含まれない場合は -1 である
+	values []T
+	op     OrderableFunc[T]
+}
+
+// NewIndexed は 要素の大小順序を定義した関数 OrderableFunc[T] を引数にとり, 空の IndexedPriorityQueue[T] を返す.
+// OrderableFunc[T] に対する条件は New と同様である.
+// Time: O(1)
+func NewIndexed[T any](operator OrderableFunc[T]) *IndexedPriorityQueue[T] {
+	return &IndexedPriorityQueue[T]{
+		heap:   []Handle{},
+		pos:    []int{},
+		values: []T{},
+		op:     operator,
+	}
+}
+
+// Len は 呼び出し時点での要素数を返す
+// Time: O(1)
+func (q *IndexedPriorityQueue[T]) Len() int {
+	return len(q.heap)
+}
+
+// Contains は handle が指す要素がキューに含まれるかを判定する
+// Time: O(1)
+func (q *IndexedPriorityQueue[T]) Contains(handle Handle) bool {
+	return 0 <= handle && int(handle) < len(q.pos) && q.pos[handle] >= 0
+}
+
+// Get は handle が指す要素の値と error 値 nil を返す.
+// handle が指す要素がキューに含まれない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (q *IndexedPriorityQueue[T]) Get(handle Handle) (T, error) {
+	if !q.Contains(handle) {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	return q.values[handle], nil
+}
+
+// Push は 渡された value 値を新たに加え, その要素を指す Handle を返す.
+// Time: O(log N)
+func (q *IndexedPriorityQueue[T]) Push(value T) Handle {
+	handle := Handle(len(q.values))
+	q.values = append(q.values, value)
+	q.pos = append(q.pos, len(q.heap))
+	q.heap = append(q.heap, handle)
+	q.up(len(q.heap) - 1)
+	return handle
+}
+
+// Peek は 最も優先される要素の Handle と値, error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (q *IndexedPriorityQueue[T]) Peek() (Handle, T, error) {
+	if len(q.heap) == 0 {
+		var zero T
+		return -1, zero, errors.ErrNotFound
+	}
+	return q.heap[0], q.values[q.heap[0]], nil
+}
+
+// Pop は 最も優先される要素を取り除き, その Handle と値, error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log N)
+func (q *IndexedPriorityQueue[T]) Pop() (Handle, T, error) {
+	if len(q.heap) == 0 {
+		var zero T
+		return -1, zero, errors.ErrNotFound
+	}
+	handle := q.heap[0]
+	value := q.values[handle]
+	q.remove(0)
+	return handle, value, nil
+}
+
+// Update は handle が指す要素の値を value に変更する.
+// handle が指す要素がキューに含まれない場合は ErrNotFound が error 値として返され,操作は棄却される.
+// Time: O(log N)
+func (q *IndexedPriorityQueue[T]) Update(handle Handle, value T) error {
+	if !q.Contains(handle) {
+		return errors.ErrNotFound
+	}
+	q.values[handle] = value
+	q.up(q.pos[handle])
+	q.down(q.pos[handle])
+	return nil
+}
+
+// Remove は handle が指す要素をキューから削除する.
+// handle が指す要素がキューに含まれない場合は ErrNotFound が error 値として返され,操作は棄却される.
+// Time: O(log N)
+func (q *IndexedPriorityQueue[T]) Remove(handle Handle) error {
+	if !q.Contains(handle) {
+		return errors.ErrNotFound
+	}
+	q.remove(q.pos[handle])
+	return nil
+}
+
+func (q *IndexedPriorityQueue[T]) remove(i int) {
+	var zero T
+	n := len(q.heap) - 1
+	handle := q.heap[i]
+	q.swap(i, n)
+	q.heap = q.heap[:n]
+	q.pos[handle] = -1
+	q.values[handle] = zero
+	if i < n {
+		moved := q.heap[i]
+		q.up(i)
+		q.down(q.pos[moved])
+	}
+}
+
+func (q *IndexedPriorityQueue[T]) less(i, j int) bool {
+	return q.op(q.values[q.heap[i]], q.values[q.heap[j]])
+}
+
+func (q *IndexedPriorityQueue[T]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.pos[q.heap[i]] = i
+	q.pos[q.heap[j]] = j
+}
+
+func (q *IndexedPriorityQueue[T]) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if !q.less(i, p) {
+			break
+		}
+		q.swap(i, p)
+		i = p
+	}
+}
+
+func (q *IndexedPriorityQueue[T]) down(i int) {
+	n := len(q.heap)
+	for {
+		best, l, r := i, 2*i+1, 2*i+2
+		if l < n && q.less(l, best) {
+			best = l
+		}
+		if r < n && q.less(r, best) {
+			best = r
+		}
+		if best == i {
+			return
+		}
+		q.swap(i, best)
+		i = best
+	}
+}
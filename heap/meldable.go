@@ -0,0 +1,93 @@
+package heap
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// AddFunc は ヒープ全体への一括加算に用いる関数である.
+// value に delta を加えた値を返さなくてはならない.
+// 遅延させた delta 同士の合成にも用いられるため 結合的でなくてはならず,
+// また 任意の a, b に対して op(a, b) ならば op(add(a, d), add(b, d)) が成り立たなくてはならない.
+type AddFunc[T any] func(value, delta T) T
+
+// meldNode は 併合可能ヒープの節点である.
+// PairingHeap では left を最初の子, right を次の兄弟として用いる.
+// lazy は 自身の値には反映済みであり, left と right 以下に未反映の加算値を表す.
+type meldNode[T any] struct {
+	value, lazy T
+	hasLazy     bool
+	rank        int
+	left, right *meldNode[T]
+}
+
+func (n *meldNode[T]) apply(delta T, add AddFunc[T]) {
+	n.value = add(n.value, delta)
+	if n.hasLazy {
+		n.lazy = add(n.lazy, delta)
+	} else {
+		n.lazy, n.hasLazy = delta, true
+	}
+}
+
+func (n *meldNode[T]) push(add AddFunc[T]) {
+	if !n.hasLazy {
+		return
+	}
+	if n.left != nil {
+		n.left.apply(n.lazy, add)
+	}
+	if n.right != nil {
+		n.right.apply(n.lazy, add)
+	}
+	var zero T
+	n.lazy, n.hasLazy = zero, false
+}
+
+// meldable は 併合可能ヒープに共通する操作を提供する.
+type meldable[T any] struct {
+	root *meldNode[T]
+	size int
+	op   OrderableFunc[T]
+	add  AddFunc[T]
+}
+
+// Len は 呼び出し時点での要素数を返す
+// Time: O(1)
+func (h *meldable[T]) Len() int {
+	return h.size
+}
+
+// Top は 最も優先される要素と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (h *meldable[T]) Top() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	return h.root.value, nil
+}
+
+// AddAll は 全要素に delta を加える.
+// 構築時に AddFunc が与えられていない場合は ErrInvalidValue が error 値として返され,操作は棄却される.
+// Time: O(1)
+func (h *meldable[T]) AddAll(delta T) error {
+	if h.add == nil {
+		return errors.ErrInvalidValue
+	}
+	if h.root != nil {
+		h.root.apply(delta, h.add)
+	}
+	return nil
+}
+
+func (h *meldable[T]) push(n *meldNode[T]) {
+	if h.add != nil {
+		n.push(h.add)
+	}
+}
+
+func (h *meldable[T]) clear() {
+	h.root, h.size = nil, 0
+}
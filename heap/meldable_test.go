@@ -0,0 +1,167 @@
+package heap_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	heap "github.com/hiden2000/go_ds/heap"
+)
+
+// meldableHeap は テスト対象の併合可能ヒープに共通する操作である
+type meldableHeap interface {
+	Push(value int)
+	Pop() (int, error)
+	Top() (int, error)
+	Len() int
+	AddAll(delta int) error
+}
+
+func less(left, right int) bool {
+	return left < right
+}
+
+func add(value, delta int) int {
+	return value + delta
+}
+
+func TestMeldable(t *testing.T) {
+	testCases := []struct {
+		name string
+		new  func() meldableHeap
+		meld func(a, b meldableHeap)
+	}{
+		{
+			name: "Pairing",
+			new:  func() meldableHeap { return heap.NewPairingHeap(less, add) },
+			meld: func(a, b meldableHeap) { a.(*heap.PairingHeap[int]).Meld(b.(*heap.PairingHeap[int])) },
+		},
+		{
+			name: "Leftist",
+			new:  func() meldableHeap { return heap.NewLeftistHeap(less, add) },
+			meld: func(a, b meldableHeap) { a.(*heap.LeftistHeap[int]).Meld(b.(*heap.LeftistHeap[int])) },
+		},
+		{
+			name: "Skew",
+			new:  func() meldableHeap { return heap.NewSkewHeap(less, add) },
+			meld: func(a, b meldableHeap) { a.(*heap.SkewHeap[int]).Meld(b.(*heap.SkewHeap[int])) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			const k, q = 5, 3000
+			rng := rand.New(rand.NewSource(1))
+			heaps := make([]meldableHeap, k)
+			naive := make([][]int, k)
+			for i := range heaps {
+				heaps[i] = tc.new()
+			}
+
+			for i := 0; i < q; i++ {
+				x := rng.Intn(k)
+				switch rng.Intn(5) {
+				case 0, 1:
+					v := rng.Intn(1000) - 500
+					heaps[x].Push(v)
+					naive[x] = append(naive[x], v)
+				case 2:
+					get, err := heaps[x].Pop()
+					if len(naive[x]) == 0 {
+						if err != errors.ErrNotFound {
+							t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+						}
+						continue
+					}
+					sort.Ints(naive[x])
+					if err != nil {
+						t.Fatal(err)
+					} else if get != naive[x][0] {
+						t.Fatalf("Expected %d, got %d instead.", naive[x][0], get)
+					}
+					naive[x] = naive[x][1:]
+				case 3:
+					d := rng.Intn(100) - 50
+					if err := heaps[x].AddAll(d); err != nil {
+						t.Fatal(err)
+					}
+					for j := range naive[x] {
+						naive[x][j] += d
+					}
+				case 4:
+					y := rng.Intn(k)
+					tc.meld(heaps[x], heaps[y])
+					if x != y {
+						naive[x] = append(naive[x], naive[y]...)
+						naive[y] = nil
+					}
+				}
+				for j := range heaps {
+					if heaps[j].Len() != len(naive[j]) {
+						t.Fatalf("Expected %d, got %d instead.", len(naive[j]), heaps[j].Len())
+					}
+					if len(naive[j]) == 0 {
+						continue
+					}
+					sort.Ints(naive[j])
+					if top, err := heaps[j].Top(); err != nil {
+						t.Fatal(err)
+					} else if top != naive[j][0] {
+						t.Fatalf("Expected %d, got %d instead.", naive[j][0], top)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestAddAllWithoutAddFunc(t *testing.T) {
+	h := heap.NewLeftistHeap[int](less, nil)
+	h.Push(1)
+	if err := h.AddAll(1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrInvalidValue, err)
+	}
+	if top, err := h.Top(); err != nil || top != 1 {
+		t.Errorf("Expected %d, got %d (%v) instead.", 1, top, err)
+	}
+}
+
+func TestPersistentLeftistHeap(t *testing.T) {
+	empty := heap.NewPersistentLeftistHeap(less)
+	a := empty.Push(5).Push(3).Push(8)
+	b := a.Push(1)
+
+	if _, _, err := empty.Pop(); err != errors.ErrNotFound {
+		t.Errorf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+	}
+
+	// b への操作は a に影響しない
+	top, c, err := b.Pop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if top != 1 {
+		t.Errorf("Expected %d, got %d instead.", 1, top)
+	}
+	if top, err := a.Top(); err != nil || top != 3 {
+		t.Errorf("Expected %d, got %d (%v) instead.", 3, top, err)
+	}
+
+	merged := a.Meld(c).Meld(empty.Push(4))
+	exp := []int{3, 3, 4, 5, 5, 8, 8}
+	if merged.Len() != len(exp) {
+		t.Errorf("Expected %d, got %d instead.", len(exp), merged.Len())
+	}
+	for _, e := range exp {
+		var get int
+		if get, merged, err = merged.Pop(); err != nil {
+			t.Fatal(err)
+		} else if get != e {
+			t.Errorf("Expected %d, got %d instead.", e, get)
+		}
+	}
+	if a.Len() != 3 || b.Len() != 4 || c.Len() != 3 {
+		t.Errorf("Original heaps must not be modified: %d, %d, %d", a.Len(), b.Len(), c.Len())
+	}
+}
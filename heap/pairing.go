@@ -0,0 +1,90 @@
+package heap
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// PairingHeap は O(1) での併合 (Meld) と ならし O(log N) での削除が可能な Pairing Heap である.
+type PairingHeap[T any] struct {
+	meldable[T]
+}
+
+// NewPairingHeap は 要素の大小順序を定義した関数 OrderableFunc[T] と 一括加算に用いる関数 AddFunc[T] を引数にとり,
+// 空の PairingHeap[T] を返す.
+// 一括加算 (AddAll) を用いない場合は add に nil を渡してよい.
+// Time: O(1)
+func NewPairingHeap[T any](operator OrderableFunc[T], add AddFunc[T]) *PairingHeap[T] {
+	return &PairingHeap[T]{meldable[T]{op: operator, add: add}}
+}
+
+// Push は 渡された value 値を新たに加える.
+// Time: O(1)
+func (h *PairingHeap[T]) Push(value T) {
+	h.root = h.link(h.root, &meldNode[T]{value: value})
+	h.size++
+}
+
+// Pop は 最も優先される要素を取り除き, その値と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log N) (amortized)
+func (h *PairingHeap[T]) Pop() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	r := h.root
+	h.push(r)
+	// 子を左から順に 2 つずつ併合し, その結果を右から順に併合する
+	pairs := []*meldNode[T]{}
+	for c := r.left; c != nil; {
+		h.push(c)
+		next := c.right
+		c.right = nil
+		if next != nil {
+			h.push(next)
+			nextNext := next.right
+			next.right = nil
+			c = h.link(c, next)
+			next = nextNext
+		}
+		pairs = append(pairs, c)
+		c = next
+	}
+	var root *meldNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	h.root = root
+	h.size--
+	return r.value, nil
+}
+
+// Meld は other の全要素を h に移す. 呼び出し後の other は空になる.
+// h と other は同じ OrderableFunc[T] と AddFunc[T] で構築されていなくてはならない.
+// Time: O(1)
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if h == other {
+		return
+	}
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.clear()
+}
+
+// link は 兄弟を持たない根 a, b を併合する
+func (h *PairingHeap[T]) link(a, b *meldNode[T]) *meldNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.op(b.value, a.value) {
+		a, b = b, a
+	}
+	h.push(a)
+	h.push(b)
+	b.right, a.left = a.left, b
+	return a
+}
@@ -0,0 +1,100 @@
+package heap
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+type persistentNode[T any] struct {
+	value       T
+	rank        int
+	left, right *persistentNode[T]
+}
+
+// PersistentLeftistHeap は 操作のたびに新たなヒープを返す 永続 Leftist Heap である.
+// 操作前のヒープは変更されず, 節点は操作前後のヒープ間で共有される.
+// k-最短路の列挙等に用いる.
+type PersistentLeftistHeap[T any] struct {
+	root *persistentNode[T]
+	size int
+	op   OrderableFunc[T]
+}
+
+// NewPersistentLeftistHeap は 要素の大小順序を定義した関数 OrderableFunc[T] を引数にとり,
+// 空の PersistentLeftistHeap[T] を返す.
+// Time: O(1)
+func NewPersistentLeftistHeap[T any](operator OrderableFunc[T]) *PersistentLeftistHeap[T] {
+	return &PersistentLeftistHeap[T]{op: operator}
+}
+
+// Len は 要素数を返す
+// Time: O(1)
+func (h *PersistentLeftistHeap[T]) Len() int {
+	return h.size
+}
+
+// Top は 最も優先される要素と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (h *PersistentLeftistHeap[T]) Top() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	return h.root.value, nil
+}
+
+// Push は h に value 値を加えたヒープを返す.
+// Time: O(log N)
+func (h *PersistentLeftistHeap[T]) Push(value T) *PersistentLeftistHeap[T] {
+	return h.with(h.meld(h.root, &persistentNode[T]{value: value, rank: 1}), h.size+1)
+}
+
+// Pop は 最も優先される要素の値と, h からその要素を取り除いたヒープ, error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log N)
+func (h *PersistentLeftistHeap[T]) Pop() (T, *PersistentLeftistHeap[T], error) {
+	if h.root == nil {
+		var zero T
+		return zero, h, errors.ErrNotFound
+	}
+	return h.root.value, h.with(h.meld(h.root.left, h.root.right), h.size-1), nil
+}
+
+// Meld は h と other の全要素を含むヒープを返す.
+// h と other は同じ OrderableFunc[T] で構築されていなくてはならない.
+// Time: O(log N)
+func (h *PersistentLeftistHeap[T]) Meld(other *PersistentLeftistHeap[T]) *PersistentLeftistHeap[T] {
+	return h.with(h.meld(h.root, other.root), h.size+other.size)
+}
+
+func (h *PersistentLeftistHeap[T]) with(root *persistentNode[T], size int) *PersistentLeftistHeap[T] {
+	return &PersistentLeftistHeap[T]{root: root, size: size, op: h.op}
+}
+
+func (h *PersistentLeftistHeap[T]) meld(a, b *persistentNode[T]) *persistentNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.op(b.value, a.value) {
+		a, b = b, a
+	}
+	n := &persistentNode[T]{value: a.value, left: a.left}
+	n.right = h.meld(a.right, b)
+	if persistentRank(n.left) < persistentRank(n.right) {
+		n.left, n.right = n.right, n.left
+	}
+	n.rank = persistentRank(n.right) + 1
+	return n
+}
+
+func persistentRank[T any](n *persistentNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.rank
+}
@@ -0,0 +1,68 @@
+package heap
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// SkewHeap は ならし O(log N) での併合 (Meld) が可能な Skew Heap である.
+type SkewHeap[T any] struct {
+	meldable[T]
+}
+
+// NewSkewHeap は 要素の大小順序を定義した関数 OrderableFunc[T] と 一括加算に用いる関数 AddFunc[T] を引数にとり,
+// 空の SkewHeap[T] を返す.
+// 一括加算 (AddAll) を用いない場合は add に nil を渡してよい.
+// Time: O(1)
+func NewSkewHeap[T any](operator OrderableFunc[T], add AddFunc[T]) *SkewHeap[T] {
+	return &SkewHeap[T]{meldable[T]{op: operator, add: add}}
+}
+
+// Push は 渡された value 値を新たに加える.
+// Time: O(log N) (amortized)
+func (h *SkewHeap[T]) Push(value T) {
+	h.root = h.meld(h.root, &meldNode[T]{value: value})
+	h.size++
+}
+
+// Pop は 最も優先される要素を取り除き, その値と error 値 nil を返す.
+// 要素がない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log N) (amortized)
+func (h *SkewHeap[T]) Pop() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, errors.ErrNotFound
+	}
+	r := h.root
+	h.push(r)
+	h.root = h.meld(r.left, r.right)
+	h.size--
+	return r.value, nil
+}
+
+// Meld は other の全要素を h に移す. 呼び出し後の other は空になる.
+// h と other は同じ OrderableFunc[T] と AddFunc[T] で構築されていなくてはならない.
+// Time: O(log N) (amortized)
+func (h *SkewHeap[T]) Meld(other *SkewHeap[T]) {
+	if h == other {
+		return
+	}
+	h.root = h.meld(h.root, other.root)
+	h.size += other.size
+	other.clear()
+}
+
+func (h *SkewHeap[T]) meld(a, b *meldNode[T]) *meldNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.op(b.value, a.value) {
+		a, b = b, a
+	}
+	h.push(a)
+	a.left, a.right = h.meld(a.right, b), a.left
+	return a
+}
@@ -0,0 +1,144 @@
+package math
+
+import (
+	"math/bits"
+	"unsafe"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Limits は 型 T で表現可能な最小値と最大値を返す
+// Time: O(1)
+func Limits[T Ints]() (min, max T) {
+	var zero T
+	size := uint(unsafe.Sizeof(zero)) * 8
+	if ^zero < 0 {
+		max = T(uint64(1)<<(size-1) - 1)
+		return -max - 1, max
+	}
+	return 0, ^zero
+}
+
+// AddChecked は a + b と error 値 nil を返す.
+// 結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func AddChecked[T Ints](a, b T) (T, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return c, errors.ErrOverflow
+	}
+	return c, nil
+}
+
+// SubChecked は a - b と error 値 nil を返す.
+// 結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func SubChecked[T Ints](a, b T) (T, error) {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return c, errors.ErrOverflow
+	}
+	return c, nil
+}
+
+// MulChecked は a * b と error 値 nil を返す.
+// 結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func MulChecked[T Ints](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	// T の最小値 * (-1) は 除算による検査が行えないため個別に判定する
+	if min, _ := Limits[T](); min < 0 && (a == min && b == ^T(0) || b == min && a == ^T(0)) {
+		return c, errors.ErrOverflow
+	}
+	if c/b != a {
+		return c, errors.ErrOverflow
+	}
+	return c, nil
+}
+
+// AbsChecked は a の絶対値と error 値 nil を返す.
+// a が T の最小値 (符号付き) の場合は ErrOverflow が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func AbsChecked[T Ints](a T) (T, error) {
+	if min, _ := Limits[T](); min < 0 && a == min {
+		return a, errors.ErrOverflow
+	}
+	return Abs(a), nil
+}
+
+// LcmChecked は与引数 (a,b) の最小公倍数( >= 0)と error 値 nil を返す.
+// (a,b) = (0,0) の場合は 0 を返す.
+// 結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log N)
+func LcmChecked[T Ints](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	a, err := AbsChecked(a)
+	if err != nil {
+		return 0, err
+	}
+	if b, err = AbsChecked(b); err != nil {
+		return 0, err
+	}
+	return MulChecked(a/Gcd(a, b), b)
+}
+
+// AddSaturating は a + b を返す. 結果が T で表現できない場合は T の最小値あるいは最大値に丸める.
+// Time: O(1)
+func AddSaturating[T Ints](a, b T) T {
+	c, err := AddChecked(a, b)
+	if err != nil {
+		return saturate[T](b > 0)
+	}
+	return c
+}
+
+// SubSaturating は a - b を返す. 結果が T で表現できない場合は T の最小値あるいは最大値に丸める.
+// Time: O(1)
+func SubSaturating[T Ints](a, b T) T {
+	c, err := SubChecked(a, b)
+	if err != nil {
+		return saturate[T](b < 0)
+	}
+	return c
+}
+
+// MulSaturating は a * b を返す. 結果が T で表現できない場合は T の最小値あるいは最大値に丸める.
+// Time: O(1)
+func MulSaturating[T Ints](a, b T) T {
+	c, err := MulChecked(a, b)
+	if err != nil {
+		return saturate[T]((a > 0) == (b > 0))
+	}
+	return c
+}
+
+func saturate[T Ints](positive bool) T {
+	min, max := Limits[T]()
+	if positive {
+		return max
+	}
+	return min
+}
+
+// MulHiLo は 128 bit 整数としての a * b の上位 64 bit と下位 64 bit を返す
+// Time: O(1)
+func MulHiLo(a, b uint64) (hi, lo uint64) {
+	return bits.Mul64(a, b)
+}
+
+// MulMod は 128 bit の中間値を用いて a * b mod m を返す.
+// m が 0 の場合は 0 除算と同様に panic する.
+// Time: O(1)
+func MulMod(a, b, m uint64) uint64 {
+	return mulMod(a, b, m)
+}
@@ -0,0 +1,128 @@
+package math
+
+import (
+	stdmath "math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestLimits(t *testing.T) {
+	if min, max := Limits[int8](); min != -128 || max != 127 {
+		t.Errorf("int8: Expected (-128, 127), got (%d, %d) instead\n", min, max)
+	}
+	if min, max := Limits[int64](); min != stdmath.MinInt64 || max != stdmath.MaxInt64 {
+		t.Errorf("int64: Expected (%d, %d), got (%d, %d) instead\n", int64(stdmath.MinInt64), int64(stdmath.MaxInt64), min, max)
+	}
+	if min, max := Limits[uint16](); min != 0 || max != stdmath.MaxUint16 {
+		t.Errorf("uint16: Expected (0, %d), got (%d, %d) instead\n", stdmath.MaxUint16, min, max)
+	}
+}
+
+// testCheckedExhaustive は 8 bit 整数の全ての組について 検査付き演算を int による計算と比較する
+func testCheckedExhaustive[T int8 | uint8](t *testing.T) {
+	min, max := Limits[T]()
+	inRange := func(v int) bool { return int(min) <= v && v <= int(max) }
+	clamp := func(v int) T {
+		if v < int(min) {
+			return min
+		}
+		if v > int(max) {
+			return max
+		}
+		return T(v)
+	}
+	ops := []struct {
+		name      string
+		checked   func(a, b T) (T, error)
+		saturated func(a, b T) T
+		exact     func(a, b int) int
+	}{
+		{name: "Add", checked: AddChecked[T], saturated: AddSaturating[T], exact: func(a, b int) int { return a + b }},
+		{name: "Sub", checked: SubChecked[T], saturated: SubSaturating[T], exact: func(a, b int) int { return a - b }},
+		{name: "Mul", checked: MulChecked[T], saturated: MulSaturating[T], exact: func(a, b int) int { return a * b }},
+	}
+	for a := int(min); a <= int(max); a++ {
+		for b := int(min); b <= int(max); b++ {
+			for _, op := range ops {
+				exp := op.exact(a, b)
+				out, err := op.checked(T(a), T(b))
+				if inRange(exp) && (err != nil || out != T(exp)) {
+					t.Fatalf("%s(%d, %d): Expected %d, got %d (%v) instead\n", op.name, a, b, exp, out, err)
+				}
+				if !inRange(exp) && err != errors.ErrOverflow {
+					t.Fatalf("%s(%d, %d): Expected %v, got %v instead\n", op.name, a, b, errors.ErrOverflow, err)
+				}
+				if out := op.saturated(T(a), T(b)); out != clamp(exp) {
+					t.Fatalf("%sSaturating(%d, %d): Expected %d, got %d instead\n", op.name, a, b, clamp(exp), out)
+				}
+			}
+			exp := Lcm(a, b)
+			out, err := LcmChecked(T(a), T(b))
+			if inRange(exp) && (err != nil || out != T(exp)) {
+				t.Fatalf("LcmChecked(%d, %d): Expected %d, got %d (%v) instead\n", a, b, exp, out, err)
+			}
+			if !inRange(exp) && err != errors.ErrOverflow {
+				t.Fatalf("LcmChecked(%d, %d): Expected %v, got %v instead\n", a, b, errors.ErrOverflow, err)
+			}
+		}
+	}
+}
+
+func TestChecked(t *testing.T) {
+	t.Run("int8", testCheckedExhaustive[int8])
+	t.Run("uint8", testCheckedExhaustive[uint8])
+}
+
+func TestAbsChecked(t *testing.T) {
+	testCases := []struct {
+		name string
+		arg  int64
+		exp  int64
+		err  error
+	}{
+		{name: "Pos", arg: 5, exp: 5},
+		{name: "Neg", arg: -5, exp: 5},
+		{name: "Max", arg: stdmath.MaxInt64, exp: stdmath.MaxInt64},
+		{name: "MinPlusOne", arg: stdmath.MinInt64 + 1, exp: stdmath.MaxInt64},
+		{name: "Min", arg: stdmath.MinInt64, err: errors.ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := AbsChecked(tc.arg)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && out != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	if _, err := LcmChecked(int64(1)<<40, int64(1)<<40-1); err != errors.ErrOverflow {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrOverflow, err)
+	}
+}
+
+func TestMulHiLoMod(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a, b, m := rng.Uint64(), rng.Uint64(), rng.Uint64()|1
+		exp := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+
+		hi, lo := MulHiLo(a, b)
+		out := new(big.Int).Lsh(new(big.Int).SetUint64(hi), 64)
+		out.Or(out, new(big.Int).SetUint64(lo))
+		if out.Cmp(exp) != 0 {
+			t.Fatalf("MulHiLo(%d, %d): Expected %v, got %v instead\n", a, b, exp, out)
+		}
+
+		expMod := new(big.Int).Mod(exp, new(big.Int).SetUint64(m)).Uint64()
+		if out := MulMod(a, b, m); out != expMod {
+			t.Fatalf("MulMod(%d, %d, %d): Expected %d, got %d instead\n", a, b, m, expMod, out)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package math
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Combination は 素数 mod を法とする階乗とその逆元を前計算し, 二項係数等の数え上げを高速に行う.
+// 各メソッドは 組合せ論的に範囲外の引数 (負の値や k > n 等) に対して一貫して 0 を返す.
+// 前計算した範囲 [0, N] を超える引数に対しても 0 を返すため, N は十分大きく取らなくてはならない.
+type Combination struct {
+	mod         int
+	fact, ifact []int
+}
+
+// NewCombination は [0, n] の範囲の階乗とその逆元を 素数 mod を法として前計算した Combination を返す.
+// mod は [2, 2^31) の範囲の素数であり, n は [0, mod) の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N + log mod)
+func NewCombination(n, mod int) (*Combination, error) {
+	if mod < 2 || mod >= 1<<31 || !IsPrime(mod) || n < 0 || n >= mod {
+		return nil, errors.ErrInvalidValue
+	}
+	c := &Combination{
+		mod:   mod,
+		fact:  make([]int, n+1),
+		ifact: make([]int, n+1),
+	}
+	c.fact[0] = 1
+	for i := 1; i <= n; i++ {
+		c.fact[i] = c.mul(c.fact[i-1], i)
+	}
+	inv, err := ModInverse(c.fact[n], mod)
+	if err != nil {
+		return nil, err
+	}
+	c.ifact[n] = inv
+	for i := n; i > 0; i-- {
+		c.ifact[i-1] = c.mul(c.ifact[i], i)
+	}
+	return c, nil
+}
+
+// Len は 前計算した範囲の上限 N を返す
+// Time: O(1)
+func (c *Combination) Len() int {
+	return len(c.fact) - 1
+}
+
+// Mod は 法を返す
+// Time: O(1)
+func (c *Combination) Mod() int {
+	return c.mod
+}
+
+// Fact は n! を返す. n が [0, N] の範囲にない場合は 0 を返す.
+// Time: O(1)
+func (c *Combination) Fact(n int) int {
+	if n < 0 || n >= len(c.fact) {
+		return 0
+	}
+	return c.fact[n]
+}
+
+// InvFact は n! の乗法逆元を返す. n が [0, N] の範囲にない場合は 0 を返す.
+// Time: O(1)
+func (c *Combination) InvFact(n int) int {
+	if n < 0 || n >= len(c.ifact) {
+		return 0
+	}
+	return c.ifact[n]
+}
+
+// Inv は n の乗法逆元を返す. n が [1, N] の範囲にない場合は 0 を返す.
+// Time: O(1)
+func (c *Combination) Inv(n int) int {
+	if n < 1 || n >= len(c.fact) {
+		return 0
+	}
+	return c.mul(c.ifact[n], c.fact[n-1])
+}
+
+// Comb は 二項係数 nCk を返す.
+// Time: O(1)
+func (c *Combination) Comb(n, k int) int {
+	if k < 0 || k > n || n >= len(c.fact) {
+		return 0
+	}
+	return c.mul(c.fact[n], c.mul(c.ifact[k], c.ifact[n-k]))
+}
+
+// Perm は 順列の総数 nPk を返す.
+// Time: O(1)
+func (c *Combination) Perm(n, k int) int {
+	if k < 0 || k > n || n >= len(c.fact) {
+		return 0
+	}
+	return c.mul(c.fact[n], c.ifact[n-k])
+}
+
+// Homogeneous は 重複組合せの総数 nHk = (n+k-1)Ck を返す.
+// n = k = 0 の場合は 1 を返す.
+// Time: O(1)
+func (c *Combination) Homogeneous(n, k int) int {
+	if n < 0 || k < 0 {
+		return 0
+	}
+	if n == 0 && k == 0 {
+		return 1
+	}
+	return c.Comb(n+k-1, k)
+}
+
+// Multinomial は 多項係数 (k_1 + k_2 + ... + k_m)! / (k_1! k_2! ... k_m!) を返す.
+// ks が空の場合は 1 を返す.
+// Time: O(M)
+func (c *Combination) Multinomial(ks ...int) int {
+	n, res := 0, 1
+	for _, k := range ks {
+		if k < 0 || k >= len(c.fact) {
+			return 0
+		}
+		n += k
+		res = c.mul(res, c.ifact[k])
+	}
+	if n >= len(c.fact) {
+		return 0
+	}
+	return c.mul(res, c.fact[n])
+}
+
+// Catalan は n 番目の Catalan 数 (2n)! / ((n+1)! n!) を返す. 2n が [0, N] の範囲にない場合は 0 を返す.
+// (n+1)! を参照しないよう C(2n, n) - C(2n, n+1) として計算する.
+// Time: O(1)
+func (c *Combination) Catalan(n int) int {
+	if n < 0 || 2*n >= len(c.fact) {
+		return 0
+	}
+	return (c.Comb(2*n, n) - c.Comb(2*n, n+1) + c.mod) % c.mod
+}
+
+// Stirling2 は 第 2 種 Stirling 数 S(n, k) (n 個の区別できる要素を k 個の空でないグループに分ける方法の数) を返す.
+// k は [0, N] の範囲になくてはならず, n に上限はない.
+// Time: O(k log n)
+func (c *Combination) Stirling2(n, k int) int {
+	if n < 0 || k < 0 || k > n || k >= len(c.fact) {
+		return 0
+	}
+	res := 0
+	for i := 0; i <= k; i++ {
+		term := c.mul(c.Comb(k, i), int(powMod(uint64(k-i), uint64(n), uint64(c.mod))))
+		if i%2 == 0 {
+			res += term
+		} else {
+			res += c.mod - term
+		}
+		res %= c.mod
+	}
+	return c.mul(res, c.ifact[k])
+}
+
+// Stirling1 は 符号なし第 1 種 Stirling 数 [n, k] (n 要素の置換のうち巡回置換 k 個に分解されるものの数) を返す.
+// N による制約はない.
+// Time: O(nk)
+func (c *Combination) Stirling1(n, k int) int {
+	if n < 0 || k < 0 || k > n {
+		return 0
+	}
+	// dp[j] = [i, j]
+	dp := make([]int, k+1)
+	dp[0] = 1 % c.mod
+	for i := 0; i < n; i++ {
+		for j := k; j >= 0; j-- {
+			v := c.mul(dp[j], i)
+			if j > 0 {
+				v = (v + dp[j-1]) % c.mod
+			}
+			dp[j] = v
+		}
+	}
+	return dp[k]
+}
+
+// Lucas は Lucas の定理により 巨大な n, k に対する二項係数 nCk を返す.
+// mod が小さい素数の場合に用いることを想定している. N >= mod - 1 で構築されていれば 各桁の二項係数を前計算から求め,
+// そうでない場合は 前計算の範囲を超える桁の二項係数を直接計算する.
+// Time: O(log_mod n) (N >= mod - 1 の場合), O(mod log_mod n) (それ以外の場合)
+func (c *Combination) Lucas(n, k int64) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	p, res := int64(c.mod), 1
+	for ; n > 0 || k > 0; n, k = n/p, k/p {
+		res = c.mul(res, c.smallComb(int(n%p), int(k%p)))
+		if res == 0 {
+			return 0
+		}
+	}
+	return res
+}
+
+// smallComb は 0 <= n < mod に対する nCk を 前計算の範囲 N に依らず返す
+func (c *Combination) smallComb(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	if n < len(c.fact) {
+		return c.Comb(n, k)
+	}
+	if k > n-k {
+		k = n - k
+	}
+	// n < mod より 分母の k! は mod と互いに素である
+	num, den := 1, 1
+	for i := 0; i < k; i++ {
+		num = c.mul(num, n-i)
+		den = c.mul(den, i+1)
+	}
+	inv, _ := ModInverse(den, c.mod)
+	return c.mul(num, inv)
+}
+
+func (c *Combination) mul(a, b int) int {
+	return int(uint64(a) * uint64(b) % uint64(c.mod))
+}
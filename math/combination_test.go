@@ -0,0 +1,204 @@
+package math
+
+import (
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestNewCombination(t *testing.T) {
+	testCases := []struct {
+		name string
+		n    int
+		mod  int
+		err  error
+	}{
+		{
+			name: "Valid",
+			n:    100,
+			mod:  1000000007,
+		},
+		{
+			name: "Boundary",
+			n:    12,
+			mod:  13,
+		},
+		{
+			name: "TooLarge",
+			n:    13,
+			mod:  13,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "NotPrime",
+			n:    5,
+			mod:  15,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "Negative",
+			n:    -1,
+			mod:  13,
+			err:  errors.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewCombination(tc.n, tc.mod); err != tc.err {
+				t.Errorf("Expected %v, got %v instead\n", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestComb(t *testing.T) {
+	const n, mod = 60, 1000000007
+	c, err := NewCombination(n, mod)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pascal の三角形 と 第 1 種, 第 2 種 Stirling 数の漸化式
+	pascal, s1, s2 := make([][]int, n+1), make([][]int, n+1), make([][]int, n+1)
+	for i := 0; i <= n; i++ {
+		pascal[i], s1[i], s2[i] = make([]int, n+1), make([]int, n+1), make([]int, n+1)
+		pascal[i][0], s1[0][0], s2[0][0] = 1, 1, 1
+		for j := 1; j <= i; j++ {
+			pascal[i][j] = (pascal[i-1][j-1] + pascal[i-1][j]) % mod
+			s1[i][j] = (s1[i-1][j-1] + (i-1)*s1[i-1][j]) % mod
+			s2[i][j] = (s2[i-1][j-1] + j*s2[i-1][j]) % mod
+		}
+	}
+
+	for i := -1; i <= n+1; i++ {
+		for j := -1; j <= n+1; j++ {
+			exp, expS1, expS2 := 0, 0, 0
+			if 0 <= j && j <= i && i <= n {
+				exp, expS1, expS2 = pascal[i][j], s1[i][j], s2[i][j]
+			}
+			if out := c.Comb(i, j); out != exp {
+				t.Fatalf("Comb(%d, %d): Expected %d, got %d instead\n", i, j, exp, out)
+			}
+			if out := c.Stirling1(i, j); i <= n && out != expS1 {
+				t.Fatalf("Stirling1(%d, %d): Expected %d, got %d instead\n", i, j, expS1, out)
+			}
+			if out := c.Stirling2(i, j); i <= n && out != expS2 {
+				t.Fatalf("Stirling2(%d, %d): Expected %d, got %d instead\n", i, j, expS2, out)
+			}
+			if 0 <= j && j <= i && i <= n {
+				if out, exp := c.Perm(i, j), c.mul(exp, c.Fact(j)); out != exp {
+					t.Fatalf("Perm(%d, %d): Expected %d, got %d instead\n", i, j, exp, out)
+				}
+			}
+		}
+	}
+
+	for i := 1; i <= n; i++ {
+		if c.mul(c.Inv(i), i) != 1 {
+			t.Fatalf("Inv(%d) * %d should be 1\n", i, i)
+		}
+	}
+}
+
+func TestCombSpecialNumbers(t *testing.T) {
+	c, err := NewCombination(100, 998244353)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	catalan := []int{1, 1, 2, 5, 14, 42, 132, 429, 1430, 4862}
+	for n, exp := range catalan {
+		if out := c.Catalan(n); out != exp {
+			t.Errorf("Catalan(%d): Expected %d, got %d instead\n", n, exp, out)
+		}
+	}
+
+	testCases := []struct {
+		name string
+		got  int
+		exp  int
+	}{
+		{name: "Multinomial", got: c.Multinomial(2, 3, 1), exp: 60},
+		{name: "MultinomialEmpty", got: c.Multinomial(), exp: 1},
+		{name: "MultinomialNegative", got: c.Multinomial(2, -1), exp: 0},
+		{name: "MultinomialTooLarge", got: c.Multinomial(60, 60), exp: 0},
+		{name: "Homogeneous", got: c.Homogeneous(3, 2), exp: 6},
+		{name: "HomogeneousZero", got: c.Homogeneous(0, 0), exp: 1},
+		{name: "HomogeneousNoBox", got: c.Homogeneous(0, 2), exp: 0},
+		{name: "CatalanTooLarge", got: c.Catalan(51), exp: 0},
+		{name: "FactOutOfRange", got: c.Fact(101), exp: 0},
+		{name: "Stirling2LargeN", got: c.Stirling2(1000, 1), exp: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, tc.got)
+			}
+		})
+	}
+}
+
+func TestCatalanSmallTable(t *testing.T) {
+	// Catalan(0) は (n+1)! = 1! を前計算の範囲外でも参照しない
+	c, err := NewCombination(0, 998244353)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := c.Catalan(0); out != 1 {
+		t.Errorf("Expected 1, got %d instead\n", out)
+	}
+	if out := c.Catalan(1); out != 0 {
+		t.Errorf("Expected 0, got %d instead\n", out)
+	}
+}
+
+func TestLucas(t *testing.T) {
+	const p = 7
+	// 前計算の範囲 N が mod - 1 より小さい場合も 同じ結果を返す
+	for _, size := range []int{p - 1, 3, 0} {
+		c, err := NewCombination(size, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testLucas(t, c, p)
+	}
+
+	c, err := NewCombination(5, 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := c.Lucas(12, 6); out != 1 {
+		t.Errorf("Expected 1, got %d instead\n", out)
+	}
+}
+
+func testLucas(t *testing.T, c *Combination, p int) {
+	t.Helper()
+	const n = 200
+	pascal := make([][]int, n+1)
+	for i := 0; i <= n; i++ {
+		pascal[i] = make([]int, n+1)
+		pascal[i][0] = 1
+		for j := 1; j <= i; j++ {
+			pascal[i][j] = (pascal[i-1][j-1] + pascal[i-1][j]) % p
+		}
+	}
+	for i := 0; i <= n; i++ {
+		for j := -1; j <= n; j++ {
+			exp := 0
+			if j >= 0 {
+				exp = pascal[i][j]
+			}
+			if out := c.Lucas(int64(i), int64(j)); out != exp {
+				t.Fatalf("Lucas(%d, %d): Expected %d, got %d instead\n", i, j, exp, out)
+			}
+		}
+	}
+
+	// 巨大な n に対して nCn = 1
+	if out := c.Lucas(1e18, 1e18); out != 1 {
+		t.Errorf("Expected %d, got %d instead\n", 1, out)
+	}
+}
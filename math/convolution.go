@@ -0,0 +1,323 @@
+package math
+
+import (
+	stdmath "math"
+	"math/bits"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// 任意の法での畳み込みと 64 bit 整数の畳み込みに用いる NTT-friendly な素数
+const (
+	nttMod1 = 754974721 // 2^24 * 45 + 1
+	nttMod2 = 167772161 // 2^25 * 5 + 1
+	nttMod3 = 469762049 // 2^26 * 7 + 1
+)
+
+// naiveThreshold は 素朴な畳み込みに切り替える短い側の長さである
+const naiveThreshold = 60
+
+// Convolution は c[k] = Σ_{i+j=k} a[i] * b[j] で定まる長さ len(a)+len(b)-1 の列 c と error 値 nil を返す.
+// a, b の一方が空の場合は空のスライスを返す.
+// 法が 2^31 未満の NTT-friendly な素数 (998244353 等) の場合は NTT を直接用い,
+// それ以外の法では 3 つの素数を法とする NTT の結果を中国剰余定理で復元する.
+// len(a)+len(b)-1 は NTT で扱える長さ (3 素数を用いる場合は 2^24) 以下でなくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O((N+M) log (N+M))
+func Convolution[M Modulus](a, b []ModInt[M]) ([]ModInt[M], error) {
+	if len(a) == 0 || len(b) == 0 {
+		return []ModInt[M]{}, nil
+	}
+	var mod M
+	m := mod.Mod()
+	x, y := make([]uint32, len(a)), make([]uint32, len(b))
+	for i := range a {
+		x[i] = a[i].v
+	}
+	for i := range b {
+		y[i] = b[i].v
+	}
+
+	direct, err := convolutionPlan(m, len(a)+len(b)-1)
+	if err != nil {
+		return nil, err
+	}
+	var z []uint32
+	if direct {
+		z = convolutionMod(x, y, m)
+	} else {
+		z1 := convolutionMod(x, y, nttMod1)
+		z2 := convolutionMod(x, y, nttMod2)
+		z3 := convolutionMod(x, y, nttMod3)
+		z = make([]uint32, len(z1))
+		for i := range z {
+			z[i] = garner3(z1[i], z2[i], z3[i], m)
+		}
+	}
+
+	res := make([]ModInt[M], len(z))
+	for i := range z {
+		res[i] = ModInt[M]{v: z[i] % m}
+	}
+	return res, nil
+}
+
+// ConvolutionInt64 は c[k] = Σ_{i+j=k} a[i] * b[j] で定まる長さ len(a)+len(b)-1 の列 c と error 値 nil を返す.
+// a, b の一方が空の場合は空のスライスを返す.
+// 結果の各要素が int64 で表現可能であれば正確な値を返す.
+// len(a)+len(b)-1 は 2^24 以下でなくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O((N+M) log (N+M))
+func ConvolutionInt64(a, b []int64) ([]int64, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return []int64{}, nil
+	}
+	if !nttFriendly(nttMod1, len(a)+len(b)-1) {
+		return nil, errors.ErrInvalidValue
+	}
+
+	const m1, m2, m3 = uint64(nttMod1), uint64(nttMod2), uint64(nttMod3)
+	const m2m3, m1m3, m1m2 = m2 * m3, m1 * m3, m1 * m2
+	_, m1m2m3 := bits.Mul64(m1m2, m3) // 2^64 を法とした値
+	i1, _ := ModInverse(m2m3%m1, m1)
+	i2, _ := ModInverse(m1m3%m2, m2)
+	i3, _ := ModInverse(m1m2%m3, m3)
+
+	conv := func(mod uint32) []uint32 {
+		x, y := make([]uint32, len(a)), make([]uint32, len(b))
+		for i := range a {
+			x[i] = uint32(modInt64(a[i], int64(mod)))
+		}
+		for i := range b {
+			y[i] = uint32(modInt64(b[i], int64(mod)))
+		}
+		return convolutionMod(x, y, mod)
+	}
+	c1, c2, c3 := conv(nttMod1), conv(nttMod2), conv(nttMod3)
+
+	// 真の値 c と 3 素数から復元した値 x (mod 2^64) の差は M1M2M3 の 0 から 4 倍 (負の値の場合) となる
+	// その倍数を x - c ≡ x (mod m1) に関する情報から特定する
+	offset := [5]uint64{0, 0, m1m2m3, 2 * m1m2m3, 3 * m1m2m3}
+	res := make([]int64, len(c1))
+	for i := range res {
+		x := uint64(c1[i]) * i1 % m1 * m2m3
+		x += uint64(c2[i]) * i2 % m2 * m1m3
+		x += uint64(c3[i]) * i3 % m3 * m1m2
+		diff := int64(c1[i]) - modInt64(int64(x), int64(m1))
+		if diff < 0 {
+			diff += int64(m1)
+		}
+		x -= offset[diff%5]
+		res[i] = int64(x)
+	}
+	return res, nil
+}
+
+// ConvolutionFloat は c[k] = Σ_{i+j=k} a[i] * b[j] で定まる長さ len(a)+len(b)-1 の列 c を 高速フーリエ変換 (FFT) により計算して返す.
+// a, b の一方が空の場合は空のスライスを返す.
+// 浮動小数点数の誤差を含むため, 結果の絶対値が大きい場合の精度は保証されない.
+// Time: O((N+M) log (N+M))
+func ConvolutionFloat[T Floats](a, b []T) []T {
+	if len(a) == 0 || len(b) == 0 {
+		return []T{}
+	}
+	n := len(a) + len(b) - 1
+	if len(a) <= naiveThreshold || len(b) <= naiveThreshold {
+		res := make([]T, n)
+		for i := range a {
+			for j := range b {
+				res[i+j] += a[i] * b[j]
+			}
+		}
+		return res
+	}
+
+	size := 1 << bits.Len(uint(n-1))
+	// 実部に a, 虚部に b を載せて 1 回の変換で済ませる
+	f := make([]complex128, size)
+	for i := range a {
+		f[i] = complex(float64(a[i]), imag(f[i]))
+	}
+	for i := range b {
+		f[i] = complex(real(f[i]), float64(b[i]))
+	}
+	fft(f, false)
+	for i := range f {
+		f[i] *= f[i]
+	}
+	fft(f, true)
+	res := make([]T, n)
+	for i := range res {
+		res[i] = T(imag(f[i]) / 2)
+	}
+	return res
+}
+
+// convolutionPlan は 法 m における長さ n の畳み込みを m を法とする NTT で直接計算するかを返す.
+// 3 素数を用いても計算できない長さの場合は ErrInvalidValue を返す.
+func convolutionPlan(m uint32, n int) (direct bool, err error) {
+	if nttFriendly(m, n) {
+		return true, nil
+	}
+	if !nttFriendly(nttMod1, n) {
+		return false, errors.ErrInvalidValue
+	}
+	return false, nil
+}
+
+// nttFriendly は 素数 m を法とする NTT で長さ n の畳み込みが計算できるかを判定する.
+// 乗算に ModContext を用いるため m は 2^31 未満でなくてはならない.
+func nttFriendly(m uint32, n int) bool {
+	return m < 1<<31 && IsPrime(m) && bits.Len(uint(n-1)) <= bits.TrailingZeros32(m-1)
+}
+
+// convolutionMod は NTT-friendly な素数 m を法として a と b の畳み込みを計算する
+func convolutionMod(a, b []uint32, m uint32) []uint32 {
+	n := len(a) + len(b) - 1
+	if len(a) <= naiveThreshold || len(b) <= naiveThreshold {
+		res := make([]uint32, n)
+		for i := range a {
+			for j := range b {
+				res[i+j] = uint32((uint64(res[i+j]) + uint64(a[i])*uint64(b[j])) % uint64(m))
+			}
+		}
+		return res
+	}
+
+	size := 1 << bits.Len(uint(n-1))
+	x, y := make([]uint32, size), make([]uint32, size)
+	copy(x, a)
+	copy(y, b)
+	// nttFriendly により m は 2^31 未満である
+	ctx, _ := NewModContext(m)
+	g := uint32(primitiveRoot(uint64(m)))
+	ntt(x, ctx, g, false)
+	ntt(y, ctx, g, false)
+	for i := range x {
+		x[i] = ctx.mul(x[i], y[i])
+	}
+	ntt(x, ctx, g, true)
+	return x[:n]
+}
+
+// ntt は 素数 ctx.m と その原始根 g を用いて a を (逆) 数論変換する. len(a) は 2 冪でなくてはならない.
+func ntt(a []uint32, ctx *ModContext, g uint32, invert bool) {
+	n := len(a)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	m, mod := ctx.m, uint64(ctx.m)
+	ws := make([]uint32, n>>1)
+	for length := 2; length <= n; length <<= 1 {
+		w := powMod(uint64(g), (mod-1)/uint64(length), mod)
+		if invert {
+			w = powMod(w, mod-2, mod)
+		}
+		half := length >> 1
+		ws[0] = 1
+		for i := 1; i < half; i++ {
+			ws[i] = ctx.mul(ws[i-1], uint32(w))
+		}
+		for i := 0; i < n; i += length {
+			lo, hi, roots := a[i:i+half], a[i+half:i+length], ws[:half]
+			for j := range lo {
+				u, v := lo[j], ctx.mul(hi[j], roots[j])
+				x, y := u+v, u+m-v
+				if x >= m {
+					x -= m
+				}
+				if y >= m {
+					y -= m
+				}
+				lo[j], hi[j] = x, y
+			}
+		}
+	}
+	if invert {
+		inv := uint32(powMod(uint64(n), mod-2, mod))
+		for i := range a {
+			a[i] = ctx.mul(a[i], inv)
+		}
+	}
+}
+
+// primitiveRoot は 素数 p の原始根のうち最小のものを返す
+func primitiveRoot(p uint64) uint64 {
+	if p == 2 {
+		return 1
+	}
+	factors, _ := Factorize(p - 1)
+	for g := uint64(2); ; g++ {
+		ok := true
+		for _, f := range factors {
+			if powMod(g, (p-1)/f.Prime, p) == 1 {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return g
+		}
+	}
+}
+
+// garner3 で用いる 1/m1 (mod m2) と 1/(m1*m2) (mod m3)
+var (
+	garnerInv12, _  = ModInverse(uint64(nttMod1)%nttMod2, nttMod2)
+	garnerInv123, _ = ModInverse(uint64(nttMod1)*nttMod2%nttMod3, nttMod3)
+)
+
+// garner3 は 3 素数を法とする剰余 (r1, r2, r3) から 法 m における値を復元する
+func garner3(r1, r2, r3, m uint32) uint32 {
+	const m1, m2, m3 = uint64(nttMod1), uint64(nttMod2), uint64(nttMod3)
+	inv12, inv123 := garnerInv12, garnerInv123
+	x1 := uint64(r1)
+	x2 := (uint64(r2) + m2 - x1%m2) % m2 * inv12 % m2
+	x3 := (uint64(r3) + m3 - (x1+x2*m1)%m3) % m3 * inv123 % m3
+	mod := uint64(m)
+	return uint32((x1 + x2*m1%mod + x3*(m1*m2%mod)) % mod)
+}
+
+// fft は 複素数列 a を (逆) 離散フーリエ変換する. len(a) は 2 冪でなくてはならない.
+func fft(a []complex128, invert bool) {
+	n := len(a)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	for length := 2; length <= n; length <<= 1 {
+		theta := 2 * stdmath.Pi / float64(length)
+		if invert {
+			theta = -theta
+		}
+		half := length >> 1
+		ws := make([]complex128, half)
+		for j := range ws {
+			ws[j] = complex(stdmath.Cos(theta*float64(j)), stdmath.Sin(theta*float64(j)))
+		}
+		for i := 0; i < n; i += length {
+			for j := 0; j < half; j++ {
+				u, v := a[i+j], a[i+j+half]*ws[j]
+				a[i+j], a[i+j+half] = u+v, u-v
+			}
+		}
+	}
+	if invert {
+		for i := range a {
+			a[i] /= complex(float64(n), 0)
+		}
+	}
+}
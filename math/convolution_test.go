@@ -0,0 +1,210 @@
+package math
+
+import (
+	"fmt"
+	stdmath "math"
+	"math/rand"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func naiveConvolution[M Modulus](a, b []ModInt[M]) []ModInt[M] {
+	if len(a) == 0 || len(b) == 0 {
+		return []ModInt[M]{}
+	}
+	res := make([]ModInt[M], len(a)+len(b)-1)
+	for i := range a {
+		for j := range b {
+			res[i+j] = res[i+j].Add(a[i].Mul(b[j]))
+		}
+	}
+	return res
+}
+
+// Mod12 は NTT-friendly でない合成数の法である
+type Mod12 struct{}
+
+func (Mod12) Mod() uint32 { return 12 }
+
+func randomModInts[M Modulus](rng *rand.Rand, n int) []ModInt[M] {
+	res := make([]ModInt[M], n)
+	for i := range res {
+		res[i] = NewModInt[M](rng.Uint32())
+	}
+	return res
+}
+
+func testConvolution[M Modulus](t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sizes := [][2]int{{0, 5}, {1, 1}, {3, 100}, {100, 100}, {257, 300}, {1000, 1}}
+	for _, size := range sizes {
+		a, b := randomModInts[M](rng, size[0]), randomModInts[M](rng, size[1])
+		out, err := Convolution(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := naiveConvolution(a, b)
+		if len(out) != len(exp) {
+			t.Fatalf("Expected length %d, got %d instead\n", len(exp), len(out))
+		}
+		for i := range exp {
+			if out[i] != exp[i] {
+				t.Fatalf("%v: index %d: Expected %v, got %v instead\n", size, i, exp[i], out[i])
+			}
+		}
+	}
+}
+
+func TestConvolution(t *testing.T) {
+	t.Run("998244353", testConvolution[Mod998244353])
+	t.Run("1000000007", testConvolution[Mod1000000007])
+	t.Run("12", testConvolution[Mod12])
+}
+
+func TestConvolutionInt64(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	testCases := []struct {
+		name string
+		n, m int
+		max  int64
+	}{
+		{name: "Empty", n: 0, m: 3, max: 10},
+		{name: "Small", n: 5, m: 7, max: 10},
+		{name: "Large", n: 300, m: 200, max: 1e6},
+		{name: "Huge", n: 100, m: 100, max: 1e8},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := make([]int64, tc.n), make([]int64, tc.m)
+			for i := range a {
+				a[i] = rng.Int63n(2*tc.max+1) - tc.max
+			}
+			for i := range b {
+				b[i] = rng.Int63n(2*tc.max+1) - tc.max
+			}
+			out, err := ConvolutionInt64(a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			exp := []int64{}
+			if len(a) > 0 && len(b) > 0 {
+				exp = make([]int64, len(a)+len(b)-1)
+			}
+			for i := range a {
+				for j := range b {
+					exp[i+j] += a[i] * b[j]
+				}
+			}
+			if fmt.Sprint(out) != fmt.Sprint(exp) {
+				t.Errorf("Expected %v, got %v instead\n", exp, out)
+			}
+		})
+	}
+
+	// 結果が int64 の境界付近となる場合
+	big := []int64{stdmath.MaxInt64 / 3}
+	if out, err := ConvolutionInt64(big, []int64{3, -3}); err != nil {
+		t.Fatal(err)
+	} else if out[0] != big[0]*3 || out[1] != -big[0]*3 {
+		t.Errorf("Expected %v, got %v instead\n", []int64{big[0] * 3, -big[0] * 3}, out)
+	}
+}
+
+func TestConvolutionPlan(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mod    uint32
+		n      int
+		direct bool
+		err    error
+	}{
+		{name: "Direct", mod: 998244353, n: 1 << 23, direct: true},
+		{name: "ThreePrimes", mod: 998244353, n: 1<<23 + 1},
+		{name: "Composite", mod: 12, n: 10},
+		{name: "NTTFriendlyLarge", mod: 3221225473, n: 10},
+		{name: "ThreePrimesMax", mod: 1000000007, n: 1 << 24},
+		{name: "TooLarge", mod: 998244353, n: 1<<24 + 1, err: errors.ErrInvalidValue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			direct, err := convolutionPlan(tc.mod, tc.n)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && direct != tc.direct {
+				t.Errorf("Expected %v, got %v instead\n", tc.direct, direct)
+			}
+		})
+	}
+}
+
+func TestConvolutionFloat(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for _, size := range [][2]int{{0, 1}, {3, 4}, {100, 200}, {1000, 999}} {
+		a, b := make([]float64, size[0]), make([]float64, size[1])
+		for i := range a {
+			a[i] = rng.Float64()*2 - 1
+		}
+		for i := range b {
+			b[i] = rng.Float64()*2 - 1
+		}
+		out := ConvolutionFloat(a, b)
+		if len(a) == 0 {
+			if len(out) != 0 {
+				t.Errorf("Expected empty, got %v instead\n", out)
+			}
+			continue
+		}
+		exp := make([]float64, len(a)+len(b)-1)
+		for i := range a {
+			for j := range b {
+				exp[i+j] += a[i] * b[j]
+			}
+		}
+		for i := range exp {
+			if stdmath.Abs(out[i]-exp[i]) > 1e-9 {
+				t.Fatalf("%v: index %d: Expected %v, got %v instead\n", size, i, exp[i], out[i])
+			}
+		}
+	}
+}
+
+func BenchmarkConvolution(b *testing.B) {
+	rng := rand.New(rand.NewSource(4))
+	for _, logN := range []int{10, 15, 20} {
+		x := randomModInts[Mod998244353](rng, 1<<logN)
+		y := randomModInts[Mod998244353](rng, 1<<logN)
+		b.Run(fmt.Sprintf("998244353/2^%d", logN), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := Convolution(x, y); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	for _, logN := range []int{10, 15, 20} {
+		x := randomModInts[Mod1000000007](rng, 1<<logN)
+		y := randomModInts[Mod1000000007](rng, 1<<logN)
+		b.Run(fmt.Sprintf("1000000007/2^%d", logN), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := Convolution(x, y); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	for _, logN := range []int{10, 15, 20} {
+		x, y := make([]float64, 1<<logN), make([]float64, 1<<logN)
+		for i := range x {
+			x[i], y[i] = rng.Float64(), rng.Float64()
+		}
+		b.Run(fmt.Sprintf("float64/2^%d", logN), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = ConvolutionFloat(x, y)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package math
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// ModPow は a^e mod m ([0, m)) と error 値 nil を返す. 0^0 = 1 とする.
+// m は [1, 2^63) の範囲になくてはならない.
+// m が範囲外の場合は ErrInvalidValue が error 値として返される.
+// Time: O(log e)
+func ModPow[T Ints](a T, e uint64, m T) (T, error) {
+	if m < 1 || int64(m) < 1 {
+		return 0, errors.ErrInvalidValue
+	}
+	return T(powMod(uint64(modInt64(a, int64(m))), e, uint64(m))), nil
+}
+
+// DiscreteLog は Baby-step Giant-step 法により a^x ≡ b (mod m) を満たす最小の x >= 0 と error 値 nil を返す.
+// a と m は互いに素でなくてもよい.
+// m は [1, 2^63) の範囲になくてはならない. m が範囲外の場合は ErrInvalidValue が error 値として返される.
+// 解が存在しない場合は ErrNotFound が error 値として返される.
+// Time: O(√m) (expected)
+func DiscreteLog[T Ints](a, b, m T) (T, error) {
+	if m < 1 || int64(m) < 1 {
+		return 0, errors.ErrInvalidValue
+	}
+	x, ok := discreteLog(uint64(modInt64(a, int64(m))), uint64(modInt64(b, int64(m))), uint64(m))
+	if !ok {
+		return 0, errors.ErrNotFound
+	}
+	return T(x), nil
+}
+
+func discreteLog(a, b, m uint64) (uint64, bool) {
+	// a と m が互いに素になるまで 両辺を gcd(a, m) で割り, cur * a^x ≡ b (mod m) の形に帰着する
+	k, cur := uint64(0), 1%m
+	for g := Gcd(a, m); g != 1; g = Gcd(a, m) {
+		if b == cur {
+			return k, true
+		}
+		if b%g != 0 {
+			return 0, false
+		}
+		b, m, k = b/g, m/g, k+1
+		cur = MulMod(cur, a/g, m)
+		b %= m
+	}
+	// x = i*n - j (1 <= i <= n, 0 <= j <= n) と表して a^j * b と cur * a^(i*n) の一致を探す
+	n := isqrt(m) + 1
+	baby := make(map[uint64]uint64, n+1)
+	for j, v := uint64(0), b%m; j <= n; j++ {
+		baby[v] = j
+		v = MulMod(v, a, m)
+	}
+	an := powMod(a, n, m)
+	for i, v := uint64(1), cur; i <= n; i++ {
+		v = MulMod(v, an, m)
+		if j, ok := baby[v]; ok {
+			return k + i*n - j, true
+		}
+	}
+	return 0, false
+}
+
+// PrimitiveRoot は 素数 p の原始根のうち最小のものと error 値 nil を返す.
+// p は 2^63 未満の素数でなくてはならない. 条件を満たさない場合は ErrInvalidValue が error 値として返される.
+// Time: O(p^(1/4) log p) (expected)
+func PrimitiveRoot[T Ints](p T) (T, error) {
+	if p < 2 || int64(p) < 2 || !isPrime(uint64(p)) {
+		return 0, errors.ErrInvalidValue
+	}
+	return T(primitiveRoot(uint64(p))), nil
+}
+
+// ModSqrt は Tonelli-Shanks 法により x^2 ≡ a (mod p) を満たす x のうち小さい方 ([0, p)) と error 値 nil を返す.
+// p は 2^63 未満の素数でなくてはならない. 条件を満たさない場合は ErrInvalidValue が error 値として返される.
+// 平方根が存在しない場合は ErrNotFound が error 値として返される.
+// Time: O(log^2 p)
+func ModSqrt[T Ints](a, p T) (T, error) {
+	if p < 2 || int64(p) < 2 || !isPrime(uint64(p)) {
+		return 0, errors.ErrInvalidValue
+	}
+	x, ok := modSqrt(uint64(modInt64(a, int64(p))), uint64(p))
+	if !ok {
+		return 0, errors.ErrNotFound
+	}
+	return T(x), nil
+}
+
+func modSqrt(a, p uint64) (uint64, bool) {
+	if a == 0 || p == 2 {
+		return a, true
+	}
+	// Euler の規準
+	if powMod(a, (p-1)/2, p) != 1 {
+		return 0, false
+	}
+	q, s := p-1, 0
+	for q%2 == 0 {
+		q, s = q/2, s+1
+	}
+	z := uint64(2)
+	for powMod(z, (p-1)/2, p) == 1 {
+		z++
+	}
+	c, x, t, m := powMod(z, q, p), powMod(a, (q+1)/2, p), powMod(a, q, p), s
+	for t != 1 {
+		i, tt := 0, t
+		for tt != 1 {
+			tt, i = MulMod(tt, tt, p), i+1
+		}
+		b := powMod(c, 1<<(m-i-1), p)
+		x, c, m = MulMod(x, b, p), MulMod(b, b, p), i
+		t = MulMod(t, c, p)
+	}
+	if p-x < x {
+		x = p - x
+	}
+	return x, true
+}
+
+// KthRootMod は x^k ≡ a (mod p) を満たす x ([0, p)) の 1 つと error 値 nil を返す. k = 0 の場合は a = 1 の場合に限り 1 を返す.
+// p は 2^63 未満の素数でなくてはならない. 条件を満たさない場合は ErrInvalidValue が error 値として返される.
+// 解が存在しない場合は ErrNotFound が error 値として返される.
+// Adleman-Manders-Miller 法を用いる. gcd(k, p-1) の素因数 r (重複を含む) ごとに O(log^2 p + √r) の計算を要するため,
+// gcd(k, p-1) が大きな素因数を持たない場合は p が 2^63 に近くても高速に動作する.
+// Time: O(Σ (log^2 p + √r)) (expected)
+func KthRootMod[T Ints](a T, k uint64, p T) (T, error) {
+	if p < 2 || int64(p) < 2 || !isPrime(uint64(p)) {
+		return 0, errors.ErrInvalidValue
+	}
+	x, ok := kthRootMod(uint64(modInt64(a, int64(p))), k, uint64(p))
+	if !ok {
+		return 0, errors.ErrNotFound
+	}
+	return T(x), nil
+}
+
+func kthRootMod(a, k, p uint64) (uint64, bool) {
+	switch {
+	case k == 0:
+		return 1, a == 1
+	case a == 0:
+		return 0, true
+	}
+	// d = gcd(k, p-1) として y^d ≡ a を満たす y を求める
+	d := Gcd(k, p-1)
+	var y uint64
+	switch d {
+	case 1:
+		y = a
+	case 2:
+		r, ok := modSqrt(a, p)
+		if !ok {
+			return 0, false
+		}
+		y = r
+	default:
+		if powMod(a, (p-1)/d, p) != 1 {
+			return 0, false
+		}
+		// d の素因数 r ごとに r 乗根をとる. a が d 乗剰余であれば その r 乗根はいずれも d/r 乗剰余である
+		y = a
+		for _, r := range factorize(d, []uint64{}) {
+			y = primeRootMod(y, r, p)
+		}
+	}
+	// u = k/d は (p-1)/d を法として可逆であり, u * v = 1 + t(p-1)/d とすると
+	// x = y^v は x^k = y^(d(1 + t(p-1)/d)) = y^d * y^(t(p-1)) ≡ a を満たす
+	q := (p - 1) / d
+	v, _ := ModInverse(k/d%q, q)
+	return powMod(y, v, p), true
+}
+
+// primeRootMod は 素数 r が p-1 を割り切り a (≠ 0) が r 乗剰余である場合に, x^r ≡ a (mod p) を満たす x の 1 つを返す.
+// Adleman-Manders-Miller 法を用いる.
+func primeRootMod(a, r, p uint64) uint64 {
+	// p-1 = r^s * t (t は r と互いに素) とする
+	s, t := 0, p-1
+	for t%r == 0 {
+		s, t = s+1, t/r
+	}
+	// u = r^(-1) mod t として x = a^u とすると x^r = a * b となり, b = a^(ur-1) の位数は r^(s-1) を割り切る
+	u, _ := ModInverse(r%t, t)
+	x := powMod(a, u, p)
+	b := powMod(a, (u*r+p-2)%(p-1), p)
+	// r 乗非剰余 z から 位数 r^s の元 c = z^t を作り, b = c^e となる e を Pohlig-Hellman 法により求める
+	z := uint64(2)
+	for powMod(z, (p-1)/r, p) == 1 {
+		z++
+	}
+	c := powMod(z, t, p)
+	cInv := powMod(c, p-2, p)
+	top := (p - 1) / t / r // r^(s-1)
+	gamma := powMod(c, top, p)
+	e, cur := uint64(0), b // cur = c^(-e) * b
+	for i, ri := 0, uint64(1); i < s; i, ri = i+1, ri*r {
+		di := orderLog(gamma, powMod(cur, top/ri, p), r, p)
+		e += di * ri
+		cur = MulMod(cur, powMod(cInv, di*ri, p), p)
+	}
+	// b の位数は r^(s-1) を割り切るため e は r の倍数であり, x * c^(-e/r) の r 乗は a * b * c^(-e) = a となる
+	return MulMod(x, powMod(cInv, e/r, p), p)
+}
+
+// orderLog は 位数 n の元 g に対し g^j ≡ h (mod p) を満たす j ([0, n)) を baby-step giant-step 法により求める.
+// h は g の生成する部分群に属さなくてはならない.
+func orderLog(g, h, n, p uint64) uint64 {
+	m := isqrt(n) + 1
+	baby := make(map[uint64]uint64, m)
+	for j, v := uint64(0), uint64(1); j < m; j++ {
+		if _, ok := baby[v]; !ok {
+			baby[v] = j
+		}
+		v = MulMod(v, g, p)
+	}
+	// h * (g^(-m))^i = g^j となる i, j を探すと h = g^(i*m + j) である
+	step := powMod(powMod(g, p-2, p), m, p)
+	for i, v := uint64(0), h; i <= m; i++ {
+		if j, ok := baby[v]; ok {
+			return (i*m + j) % n
+		}
+		v = MulMod(v, step, p)
+	}
+	return 0
+}
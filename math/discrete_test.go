@@ -0,0 +1,189 @@
+package math
+
+import (
+	"math/rand"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestModPow(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    int64
+		e    uint64
+		m    int64
+		exp  int64
+	}{
+		{name: "Small", a: 3, e: 4, m: 7, exp: 4},
+		{name: "ZeroZero", a: 0, e: 0, m: 7, exp: 1},
+		{name: "ModOne", a: 5, e: 0, m: 1, exp: 0},
+		{name: "Neg", a: -2, e: 3, m: 7, exp: 6},
+		{name: "Fermat", a: 123456789, e: 1000000006, m: 1000000007, exp: 1},
+		{name: "LargeMod", a: 2, e: 62, m: 4611686018427387847, exp: 4611686018427387904 % 4611686018427387847},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ModPow(tc.a, tc.e, tc.m)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %d, got %d (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+
+	if _, err := ModPow(2, 3, 0); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestDiscreteLog(t *testing.T) {
+	// 全ての (a, b, m) について 素朴な探索と比較する
+	for m := int64(1); m <= 60; m++ {
+		for a := int64(0); a < m; a++ {
+			// a^x mod m の最初の出現位置を求める. 周期は m 以下である
+			first := map[int64]int64{}
+			v := 1 % m
+			for x := int64(0); x <= 2*m; x++ {
+				if _, ok := first[v]; !ok {
+					first[v] = x
+				}
+				v = v * a % m
+			}
+			for b := int64(0); b < m; b++ {
+				out, err := DiscreteLog(a, b, m)
+				exp, ok := first[b]
+				if !ok {
+					if err != errors.ErrNotFound {
+						t.Fatalf("DiscreteLog(%d, %d, %d): Expected %v, got %d (%v) instead\n", a, b, m, errors.ErrNotFound, out, err)
+					}
+					continue
+				}
+				if err != nil || out != exp {
+					t.Fatalf("DiscreteLog(%d, %d, %d): Expected %d, got %d (%v) instead\n", a, b, m, exp, out, err)
+				}
+			}
+		}
+	}
+
+	const p = 998244353
+	if out, err := DiscreteLog(3, 123456789, p); err != nil || powMod(3, uint64(out), p) != 123456789 {
+		t.Errorf("Expected a solution, got %d (%v) instead\n", out, err)
+	}
+	if _, err := DiscreteLog(2, 3, 0); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestPrimitiveRoot(t *testing.T) {
+	testCases := []struct {
+		name string
+		p    int64
+		exp  int64
+	}{
+		{name: "2", p: 2, exp: 1},
+		{name: "7", p: 7, exp: 3},
+		{name: "998244353", p: 998244353, exp: 3},
+		{name: "1000000007", p: 1000000007, exp: 5},
+		{name: "167772161", p: 167772161, exp: 3},
+		{name: "754974721", p: 754974721, exp: 11},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := PrimitiveRoot(tc.p)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %d, got %d (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+
+	for _, p := range []int64{0, 1, 12, -7} {
+		if _, err := PrimitiveRoot(p); err != errors.ErrInvalidValue {
+			t.Errorf("PrimitiveRoot(%d): Expected %v, got %v instead\n", p, errors.ErrInvalidValue, err)
+		}
+	}
+}
+
+func TestModSqrt(t *testing.T) {
+	for _, p := range []int64{2, 3, 5, 13, 17, 97, 257} {
+		squares := map[int64]int64{}
+		for x := p - 1; x >= 0; x-- {
+			squares[x*x%p] = x
+		}
+		for a := int64(0); a < p; a++ {
+			out, err := ModSqrt(a, p)
+			exp, ok := squares[a]
+			if !ok {
+				if err != errors.ErrNotFound {
+					t.Fatalf("ModSqrt(%d, %d): Expected %v, got %v instead\n", a, p, errors.ErrNotFound, err)
+				}
+				continue
+			}
+			if err != nil || out != exp {
+				t.Fatalf("ModSqrt(%d, %d): Expected %d, got %d (%v) instead\n", a, p, exp, out, err)
+			}
+		}
+	}
+
+	const p = 4611686018427387847
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		x := rng.Int63n(p)
+		a := int64(MulMod(uint64(x), uint64(x), p))
+		out, err := ModSqrt(a, int64(p))
+		if err != nil || (out != x && out != p-x) {
+			t.Fatalf("ModSqrt(%d, %d): Expected %d, got %d (%v) instead\n", a, int64(p), x, out, err)
+		}
+	}
+
+	if _, err := ModSqrt(4, 15); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestKthRootMod(t *testing.T) {
+	for _, p := range []int64{2, 3, 7, 13, 31, 61, 97, 181, 257} {
+		for k := uint64(0); k < 20; k++ {
+			powers := map[int64]bool{}
+			for x := int64(0); x < p; x++ {
+				powers[int64(powMod(uint64(x), k, uint64(p)))] = true
+			}
+			for a := int64(0); a < p; a++ {
+				out, err := KthRootMod(a, k, p)
+				if !powers[a] {
+					if err != errors.ErrNotFound {
+						t.Fatalf("KthRootMod(%d, %d, %d): Expected %v, got %v instead\n", a, k, p, errors.ErrNotFound, err)
+					}
+					continue
+				}
+				if err != nil || int64(powMod(uint64(out), k, uint64(p))) != a {
+					t.Fatalf("KthRootMod(%d, %d, %d): got %d (%v)\n", a, k, p, out, err)
+				}
+			}
+		}
+	}
+
+	const p = 998244353
+	a := int64(powMod(12345, 1<<10, p))
+	if out, err := KthRootMod(a, 1<<10, p); err != nil || int64(powMod(uint64(out), 1<<10, p)) != a {
+		t.Errorf("got %d (%v)\n", out, err)
+	}
+	// p-1 = 2^4 * 3^3 * 5^2 * 7 * 11 * 11091115965417 であり, 2^63 に近い p でも gcd(k, p-1) が小さな素因数のみを持てば高速に求まる
+	const q = 9223372036840777201
+	for _, k := range []uint64{3, 9, 27 * 25, 16 * 27 * 25 * 7 * 11, 1 << 40 * 27 * 49} {
+		for _, x := range []uint64{2, 12345, q - 2} {
+			a := powMod(x, k, q)
+			out, err := KthRootMod(int64(a), k, q)
+			if err != nil || powMod(uint64(out), k, q) != a {
+				t.Errorf("KthRootMod(%d, %d, %d): got %d (%v)\n", a, k, uint64(q), out, err)
+			}
+		}
+	}
+	if _, err := KthRootMod(2, 3, q); err != errors.ErrNotFound {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrNotFound, err)
+	}
+	if _, err := KthRootMod(2, 3, 1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
@@ -0,0 +1,128 @@
+package math
+
+import (
+	"math/bits"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// ExtGcd は 拡張ユークリッドの互除法により a*x + b*y = g を満たす (g, x, y) を返す.
+// g は (a,b) の最大公約数( >= 0)であり, (x, y) は |x| <= |b|/g, |y| <= |a|/g を満たす.
+// a, b は int64 で表現可能な値でなくてはならない.
+// T が符号なし整数型の場合 負の係数は T の範囲で折り返した値として返される.
+// Time: O(log N)
+func ExtGcd[T Ints](a, b T) (g, x, y T) {
+	g64, x64, y64 := extGcd(int64(a), int64(b))
+	return T(g64), T(x64), T(y64)
+}
+
+func extGcd(a, b int64) (int64, int64, int64) {
+	x0, y0, x1, y1 := int64(1), int64(0), int64(0), int64(1)
+	for b != 0 {
+		q := a / b
+		a, b = b, a-q*b
+		x0, x1 = x1, x0-q*x1
+		y0, y1 = y1, y0-q*y1
+	}
+	if a < 0 {
+		a, x0, y0 = -a, -x0, -y0
+	}
+	return a, x0, y0
+}
+
+// ModInverse は 法 m における a の乗法逆元 ([0, m)) と error 値 nil を返す.
+// m は [1, 2^63) の範囲になくてはならない.
+// m が範囲外の場合, あるいは a と m が互いに素でない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log m)
+func ModInverse[T Ints](a, m T) (T, error) {
+	if m < 1 || int64(m) < 1 {
+		return 0, errors.ErrInvalidValue
+	}
+	x, err := invMod(modInt64(a, int64(m)), int64(m))
+	if err != nil {
+		return 0, err
+	}
+	return T(x), nil
+}
+
+// CRT は 連立合同式 x ≡ rs[i] (mod ms[i]) の解を x ≡ r (mod m) として (r, m) と error 値 nil を返す.
+// r は [0, m) の範囲にあり, m は ms の総積である. 連立合同式が空の場合は (0, 1) を返す.
+// ms の要素は [1, 2^63) の範囲にあり, 互いに素でなくてはならない.
+// また rs と ms の長さは等しく, m は T で表現可能でなくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log M)
+func CRT[T Ints](rs, ms []T) (r, m T, err error) {
+	return crt(rs, ms, true)
+}
+
+// GeneralizedCRT は 互いに素とは限らない法に関する連立合同式 x ≡ rs[i] (mod ms[i]) の解を
+// x ≡ r (mod m) として (r, m) と error 値 nil を返す.
+// r は [0, m) の範囲にあり, m は ms の最小公倍数である. 連立合同式が空の場合は (0, 1) を返す.
+// 解が存在しない場合は ErrNotFound が error 値として返される.
+// ms の要素は [1, 2^63) の範囲になくてはならない.
+// また rs と ms の長さは等しく, m は T で表現可能でなくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log M)
+func GeneralizedCRT[T Ints](rs, ms []T) (r, m T, err error) {
+	return crt(rs, ms, false)
+}
+
+func crt[T Ints](rs, ms []T, coprime bool) (T, T, error) {
+	if len(rs) != len(ms) {
+		return 0, 0, errors.ErrInvalidValue
+	}
+	r0, m0 := int64(0), int64(1)
+	for i := range ms {
+		m1 := int64(ms[i])
+		if ms[i] < 1 || m1 < 1 {
+			return 0, 0, errors.ErrInvalidValue
+		}
+		r1 := modInt64(rs[i], m1)
+		if m0 < m1 {
+			r0, r1, m0, m1 = r1, r0, m1, m0
+		}
+		g, im, _ := extGcd(m0, m1)
+		if coprime && g != 1 {
+			return 0, 0, errors.ErrInvalidValue
+		}
+		if (r1-r0)%g != 0 {
+			return 0, 0, errors.ErrNotFound
+		}
+		if m0%m1 == 0 {
+			continue
+		}
+		u1 := m1 / g
+		if hi, lo := bits.Mul64(uint64(m0), uint64(u1)); hi != 0 || lo >= 1<<63 {
+			return 0, 0, errors.ErrInvalidValue
+		}
+		x := int64(mulMod(uint64(modInt64((r1-r0)/g, u1)), uint64(modInt64(im, u1)), uint64(u1)))
+		r0 += x * m0
+		m0 *= u1
+	}
+	if r, m := T(r0), T(m0); int64(r) != r0 || int64(m) != m0 || m < 1 {
+		return 0, 0, errors.ErrInvalidValue
+	}
+	return T(r0), T(m0), nil
+}
+
+// modInt64 は v を m (>= 1) で割った余り ([0, m)) を返す
+func modInt64[T Ints](v T, m int64) int64 {
+	if v < 0 {
+		r := int64(v) % m
+		if r < 0 {
+			r += m
+		}
+		return r
+	}
+	return int64(uint64(v) % uint64(m))
+}
+
+// mulMod は 128 bit の中間値を用いて a * b mod m を返す
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a%m, b%m)
+	_, rem := bits.Div64(hi, lo, m)
+	return rem
+}
@@ -0,0 +1,262 @@
+package math
+
+import (
+	"math/rand"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestExtGcd(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    int64
+		b    int64
+		exp  int64
+	}{
+		{
+			name: "Pos_Pos",
+			a:    240,
+			b:    46,
+			exp:  2,
+		},
+		{
+			name: "Zero_Pos",
+			a:    0,
+			b:    7,
+			exp:  7,
+		},
+		{
+			name: "Zero_Zero",
+			a:    0,
+			b:    0,
+			exp:  0,
+		},
+		{
+			name: "Neg_Pos",
+			a:    -4,
+			b:    6,
+			exp:  2,
+		},
+		{
+			name: "Neg_Neg",
+			a:    -35,
+			b:    -15,
+			exp:  5,
+		},
+		{
+			name: "Large",
+			a:    1 << 62,
+			b:    (1 << 62) - 1,
+			exp:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, x, y := ExtGcd(tc.a, tc.b)
+			if g != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, g)
+			}
+			if tc.a*x+tc.b*y != g {
+				t.Errorf("%d * %d + %d * %d should be %d\n", tc.a, x, tc.b, y, g)
+			}
+		})
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    int
+		m    int
+		exp  int
+		err  error
+	}{
+		{
+			name: "Prime",
+			a:    3,
+			m:    7,
+			exp:  5,
+		},
+		{
+			name: "Neg",
+			a:    -3,
+			m:    7,
+			exp:  2,
+		},
+		{
+			name: "ModOne",
+			a:    5,
+			m:    1,
+			exp:  0,
+		},
+		{
+			name: "NotCoprime",
+			a:    6,
+			m:    9,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "InvalidMod",
+			a:    1,
+			m:    0,
+			err:  errors.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ModInverse(tc.a, tc.m)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && out != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	if out, err := ModInverse(uint64(3), uint64(1<<63-25)); err != nil {
+		t.Fatal(err)
+	} else if mulMod(out, 3, 1<<63-25) != 1 {
+		t.Errorf("%d * 3 should be 1\n", out)
+	}
+}
+
+func TestCRT(t *testing.T) {
+	testCases := []struct {
+		name string
+		rs   []int64
+		ms   []int64
+		expR int64
+		expM int64
+		err  error
+		gerr error // GeneralizedCRT の error 値
+	}{
+		{
+			name: "Empty",
+			expR: 0,
+			expM: 1,
+		},
+		{
+			name: "Coprime",
+			rs:   []int64{2, 3, 2},
+			ms:   []int64{3, 5, 7},
+			expR: 23,
+			expM: 105,
+		},
+		{
+			name: "NegativeRemainder",
+			rs:   []int64{-1, -1},
+			ms:   []int64{4, 9},
+			expR: 35,
+			expM: 36,
+		},
+		{
+			name: "NotCoprime",
+			rs:   []int64{3, 5},
+			ms:   []int64{4, 6},
+			expR: 11,
+			expM: 12,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "NoSolution",
+			rs:   []int64{0, 1},
+			ms:   []int64{4, 6},
+			err:  errors.ErrInvalidValue,
+			gerr: errors.ErrNotFound,
+		},
+		{
+			name: "Divisible",
+			rs:   []int64{5, 1},
+			ms:   []int64{12, 4},
+			expR: 5,
+			expM: 12,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "LargeModuli",
+			rs:   []int64{1, 2},
+			ms:   []int64{1000000007, 998244353},
+			expR: 993328913953302350,
+			expM: 998244359987710471,
+		},
+		{
+			name: "Overflow",
+			rs:   []int64{1, 2},
+			ms:   []int64{1 << 40, 1<<40 - 1},
+			err:  errors.ErrInvalidValue,
+			gerr: errors.ErrInvalidValue,
+		},
+		{
+			name: "InvalidModulus",
+			rs:   []int64{1},
+			ms:   []int64{0},
+			err:  errors.ErrInvalidValue,
+			gerr: errors.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, m, err := CRT(tc.rs, tc.ms)
+			if err != tc.err {
+				t.Errorf("CRT: Expected %v, got %v instead\n", tc.err, err)
+			} else if err == nil && (r != tc.expR || m != tc.expM) {
+				t.Errorf("CRT: Expected (%d, %d), got (%d, %d) instead\n", tc.expR, tc.expM, r, m)
+			}
+
+			r, m, err = GeneralizedCRT(tc.rs, tc.ms)
+			if err != tc.gerr {
+				t.Errorf("GeneralizedCRT: Expected %v, got %v instead\n", tc.gerr, err)
+			} else if err == nil && (r != tc.expR || m != tc.expM) {
+				t.Errorf("GeneralizedCRT: Expected (%d, %d), got (%d, %d) instead\n", tc.expR, tc.expM, r, m)
+			}
+		})
+	}
+
+	if _, _, err := CRT([]int32{1, 2}, []int32{1 << 20, 1<<20 - 1}); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestGeneralizedCRTRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		n := rng.Intn(4) + 1
+		rs, ms := make([]int, n), make([]int, n)
+		for j := range ms {
+			ms[j] = rng.Intn(20) + 1
+			rs[j] = rng.Intn(100) - 50
+		}
+		r, m, err := GeneralizedCRT(rs, ms)
+
+		// 素朴に解を探索する
+		lcm := 1
+		for _, v := range ms {
+			lcm = Lcm(lcm, v)
+		}
+		exp := -1
+		for x := 0; x < lcm && exp < 0; x++ {
+			ok := true
+			for j := range ms {
+				ok = ok && ((x-rs[j])%ms[j]+ms[j])%ms[j] == 0
+			}
+			if ok {
+				exp = x
+			}
+		}
+
+		if exp < 0 {
+			if err != errors.ErrNotFound {
+				t.Fatalf("Expected %v, got %v instead\n", errors.ErrNotFound, err)
+			}
+		} else if err != nil {
+			t.Fatal(err)
+		} else if r != exp || m != lcm {
+			t.Fatalf("Expected (%d, %d), got (%d, %d) instead\n", exp, lcm, r, m)
+		}
+	}
+}
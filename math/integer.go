@@ -0,0 +1,157 @@
+package math
+
+import (
+	stdmath "math"
+	"math/bits"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// ISqrt は floor(√n) と error 値 nil を返す.
+// n < 0 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(1)
+func ISqrt[T Ints](n T) (T, error) {
+	if n < 0 {
+		return 0, errors.ErrInvalidValue
+	}
+	return T(isqrt(uint64(n))), nil
+}
+
+// IKthRoot は floor(n^(1/k)) と error 値 nil を返す. 64 bit の範囲で厳密な値を返す.
+// n < 0 あるいは k < 1 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(log k)
+func IKthRoot[T Ints](n T, k int) (T, error) {
+	if n < 0 || k < 1 {
+		return 0, errors.ErrInvalidValue
+	}
+	u := uint64(n)
+	switch {
+	case k == 1 || u <= 1:
+		return n, nil
+	case k == 2:
+		return T(isqrt(u)), nil
+	case k >= 64:
+		// u < 2^64 より 根は 1 である
+		return 1, nil
+	}
+	// fits(x) は x^k <= u であるかを返す
+	fits := func(x uint64) bool {
+		p := uint64(1)
+		for i := 0; i < k; i++ {
+			hi, lo := bits.Mul64(p, x)
+			if hi != 0 || lo > u {
+				return false
+			}
+			p = lo
+		}
+		return true
+	}
+	x := uint64(stdmath.Pow(float64(u), 1/float64(k)))
+	for x > 0 && !fits(x) {
+		x--
+	}
+	for fits(x + 1) {
+		x++
+	}
+	return T(x), nil
+}
+
+// FloorDiv は floor(a / b) を返す. 負の値に対しても -∞ 方向へ丸める.
+// b = 0 の場合は 組み込みの除算と同様に panic する.
+// Time: O(1)
+func FloorDiv[T Ints](a, b T) T {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// CeilDiv は ceil(a / b) を返す. 負の値に対しても +∞ 方向へ丸める.
+// b = 0 の場合は 組み込みの除算と同様に panic する.
+// Time: O(1)
+func CeilDiv[T Ints](a, b T) T {
+	q := a / b
+	if a%b != 0 && (a < 0) == (b < 0) {
+		q++
+	}
+	return q
+}
+
+// Pow は a^e と error 値 nil を返す. 0^0 = 1 とする.
+// 結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// Time: O(log e)
+func Pow[T Ints](a T, e uint64) (T, error) {
+	res := T(1)
+	var err error
+	for {
+		if e&1 == 1 {
+			if res, err = MulChecked(res, a); err != nil {
+				return 0, err
+			}
+		}
+		if e >>= 1; e == 0 {
+			return res, nil
+		}
+		// 残りの指数が正であるため a^2 のオーバーフローは結果のオーバーフローを意味する
+		if a, err = MulChecked(a, a); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// FloorSum は Σ_{i=0}^{n-1} floor((a*i + b) / m) と error 値 nil を返す.
+// n < 0 あるいは m < 1 の場合は ErrInvalidValue が error 値として返される.
+// 結果が T で表現できない場合の返り値は不定である.
+// Time: O(log m)
+func FloorSum[T Ints](n, m, a, b T) (T, error) {
+	if n < 0 || m < 1 {
+		return 0, errors.ErrInvalidValue
+	}
+	un, um := uint64(n), uint64(m)
+	// 以下の計算は 全て 2^64 を法として行う
+	var ans uint64
+	if a < 0 {
+		a2 := uint64(modInt64(a, int64(m)))
+		ans -= triangular(un) * ((a2 - uint64(a)) / um)
+		a = T(a2)
+	}
+	if b < 0 {
+		b2 := uint64(modInt64(b, int64(m)))
+		ans -= un * ((b2 - uint64(b)) / um)
+		b = T(b2)
+	}
+	return T(ans + floorSumUnsigned(un, um, uint64(a), uint64(b))), nil
+}
+
+// floorSumUnsigned は a, b >= 0 に対する Σ_{i=0}^{n-1} floor((a*i + b) / m) を 2^64 を法として返す
+func floorSumUnsigned(n, m, a, b uint64) uint64 {
+	var ans uint64
+	for {
+		if a >= m {
+			ans += triangular(n) * (a / m)
+			a %= m
+		}
+		if b >= m {
+			ans += n * (b / m)
+			b %= m
+		}
+		// y = a*n + b < m*(n+1) は 128 bit で計算する
+		hi, lo := bits.Mul64(a, n)
+		lo, carry := bits.Add64(lo, b, 0)
+		hi += carry
+		if hi == 0 && lo < m {
+			return ans
+		}
+		n, b = bits.Div64(hi, lo, m)
+		m, a = a, m
+	}
+}
+
+// triangular は n(n-1)/2 を 2^64 を法として返す
+func triangular(n uint64) uint64 {
+	if n%2 == 0 {
+		return n / 2 * (n - 1)
+	}
+	return (n - 1) / 2 * n
+}
@@ -0,0 +1,188 @@
+package math
+
+import (
+	stdmath "math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestISqrt(t *testing.T) {
+	testCases := []struct {
+		name string
+		arg  uint64
+		exp  uint64
+	}{
+		{name: "Zero", arg: 0, exp: 0},
+		{name: "One", arg: 1, exp: 1},
+		{name: "Square", arg: 1 << 40, exp: 1 << 20},
+		{name: "BelowSquare", arg: 1<<40 - 1, exp: 1<<20 - 1},
+		{name: "LargeSquare", arg: 4294967295 * 4294967295, exp: 4294967295},
+		{name: "BelowLargeSquare", arg: 4294967295*4294967295 - 1, exp: 4294967294},
+		{name: "MaxUint64", arg: stdmath.MaxUint64, exp: 4294967295},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ISqrt(tc.arg)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %d, got %d (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+
+	if _, err := ISqrt(-1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestIKthRoot(t *testing.T) {
+	testCases := []struct {
+		name string
+		n    uint64
+		k    int
+		exp  uint64
+	}{
+		{name: "Zero", n: 0, k: 5, exp: 0},
+		{name: "K1", n: 12345, k: 1, exp: 12345},
+		{name: "Cube", n: 1000000, k: 3, exp: 100},
+		{name: "BelowCube", n: 999999, k: 3, exp: 99},
+		{name: "MaxCube", n: stdmath.MaxUint64, k: 3, exp: 2642245},
+		{name: "Pow2", n: 1 << 63, k: 63, exp: 2},
+		{name: "BelowPow2", n: 1<<63 - 1, k: 63, exp: 1},
+		{name: "K64", n: stdmath.MaxUint64, k: 64, exp: 1},
+		{name: "LargeK", n: stdmath.MaxUint64, k: 1000, exp: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := IKthRoot(tc.n, tc.k)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %d, got %d (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		n, k := rng.Uint64()>>uint(rng.Intn(64)), 2+rng.Intn(10)
+		x, _ := IKthRoot(n, k)
+		bn := new(big.Int).SetUint64(n)
+		lo := new(big.Int).Exp(new(big.Int).SetUint64(x), big.NewInt(int64(k)), nil)
+		hi := new(big.Int).Exp(new(big.Int).SetUint64(x+1), big.NewInt(int64(k)), nil)
+		if lo.Cmp(bn) > 0 || hi.Cmp(bn) <= 0 {
+			t.Fatalf("IKthRoot(%d, %d): got %d\n", n, k, x)
+		}
+	}
+
+	for _, k := range []int{0, -1} {
+		if _, err := IKthRoot(8, k); err != errors.ErrInvalidValue {
+			t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+		}
+	}
+	if _, err := IKthRoot(-8, 3); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestFloorCeilDiv(t *testing.T) {
+	testCases := []struct {
+		name  string
+		a     int
+		b     int
+		floor int
+		ceil  int
+	}{
+		{name: "Pos_Pos", a: 7, b: 2, floor: 3, ceil: 4},
+		{name: "Neg_Pos", a: -7, b: 2, floor: -4, ceil: -3},
+		{name: "Pos_Neg", a: 7, b: -2, floor: -4, ceil: -3},
+		{name: "Neg_Neg", a: -7, b: -2, floor: 3, ceil: 4},
+		{name: "Exact", a: -6, b: 3, floor: -2, ceil: -2},
+		{name: "Zero", a: 0, b: -5, floor: 0, ceil: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if out := FloorDiv(tc.a, tc.b); out != tc.floor {
+				t.Errorf("FloorDiv: Expected %d, got %d instead\n", tc.floor, out)
+			}
+			if out := CeilDiv(tc.a, tc.b); out != tc.ceil {
+				t.Errorf("CeilDiv: Expected %d, got %d instead\n", tc.ceil, out)
+			}
+		})
+	}
+
+	if out := CeilDiv(uint8(255), 2); out != 128 {
+		t.Errorf("Expected 128, got %d instead\n", out)
+	}
+}
+
+func TestPow(t *testing.T) {
+	// 8 bit 整数の全ての組について big.Int による計算と比較する
+	for a := -128; a < 128; a++ {
+		for e := uint64(0); e < 10; e++ {
+			exp := new(big.Int).Exp(big.NewInt(int64(a)), new(big.Int).SetUint64(e), nil)
+			out, err := Pow(int8(a), e)
+			if exp.IsInt64() && -128 <= exp.Int64() && exp.Int64() < 128 {
+				if err != nil || int64(out) != exp.Int64() {
+					t.Fatalf("Pow(%d, %d): Expected %v, got %d (%v) instead\n", a, e, exp, out, err)
+				}
+			} else if err != errors.ErrOverflow {
+				t.Fatalf("Pow(%d, %d): Expected %v, got %v instead\n", a, e, errors.ErrOverflow, err)
+			}
+		}
+	}
+
+	if out, err := Pow(uint64(3), 40); err != nil || out != 12157665459056928801 {
+		t.Errorf("Expected 12157665459056928801, got %d (%v) instead\n", out, err)
+	}
+	if _, err := Pow(uint64(3), 41); err != errors.ErrOverflow {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrOverflow, err)
+	}
+	if out, err := Pow(int64(1), stdmath.MaxUint64); err != nil || out != 1 {
+		t.Errorf("Expected 1, got %d (%v) instead\n", out, err)
+	}
+}
+
+func TestFloorSum(t *testing.T) {
+	naive := func(n, m, a, b int64) int64 {
+		var s int64
+		for i := int64(0); i < n; i++ {
+			s += FloorDiv(a*i+b, m)
+		}
+		return s
+	}
+	for n := int64(0); n < 20; n++ {
+		for m := int64(1); m < 20; m++ {
+			for a := int64(-20); a < 20; a++ {
+				for b := int64(-20); b < 20; b++ {
+					exp := naive(n, m, a, b)
+					if out, err := FloorSum(n, m, a, b); err != nil || out != exp {
+						t.Fatalf("FloorSum(%d, %d, %d, %d): Expected %d, got %d (%v) instead\n", n, m, a, b, exp, out, err)
+					}
+				}
+			}
+		}
+	}
+
+	// a*n が 64 bit を超える場合
+	n, m, a, b := uint64(100000), uint64(999999999999999989), uint64(999999999999999877), uint64(123456789)
+	exp := new(big.Int)
+	for i := uint64(0); i < n; i++ {
+		v := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(i))
+		v.Add(v, new(big.Int).SetUint64(b))
+		exp.Add(exp, v.Div(v, new(big.Int).SetUint64(m)))
+	}
+	if out, err := FloorSum(n, m, a, b); err != nil || out != exp.Uint64() {
+		t.Errorf("Expected %v, got %d (%v) instead\n", exp, out, err)
+	}
+
+	if _, err := FloorSum(-1, 1, 0, 0); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := FloorSum(1, 0, 0, 0); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
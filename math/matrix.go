@@ -0,0 +1,446 @@
+package math
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Semiring は 行列の成分が属する半環の演算を定める.
+// Add は結合的かつ可換で単位元 Zero を持ち, Mul は結合的で単位元 One を持ち,
+// Mul は Add に対して分配的であり, Zero は Mul に関して零元でなくてはならない.
+type Semiring[T any] interface {
+	Zero() T
+	One() T
+	Add(a, b T) T
+	Mul(a, b T) T
+}
+
+// pivoter は ピボットの選択に用いる大小関係を持つ体である. Less(a, b) は a の絶対値が b より小さいかを判定する.
+// 体がこれを実装する場合 消去法は部分ピボット選択 (列中で絶対値最大の成分をピボットとする) を行い, 丸め誤差を抑える.
+type pivoter[T any] interface {
+	Less(a, b T) bool
+}
+
+// Field は 行列式・階数・逆行列・連立一次方程式の計算に必要な 体の演算を定める.
+type Field[T any] interface {
+	Semiring[T]
+	Sub(a, b T) T
+	// Inv は a の乗法逆元を返す. a が零元とみなされる場合は ErrInvalidValue を返す.
+	Inv(a T) (T, error)
+	// IsZero は a を零元とみなすかを判定する
+	IsZero(a T) bool
+}
+
+// ModField は 素数を法とする剰余体 Z/MZ である.
+type ModField[M Modulus] struct{}
+
+func (ModField[M]) Zero() ModInt[M]                    { return ModInt[M]{} }
+func (ModField[M]) One() ModInt[M]                     { return NewModInt[M](1) }
+func (ModField[M]) Add(a, b ModInt[M]) ModInt[M]       { return a.Add(b) }
+func (ModField[M]) Sub(a, b ModInt[M]) ModInt[M]       { return a.Sub(b) }
+func (ModField[M]) Mul(a, b ModInt[M]) ModInt[M]       { return a.Mul(b) }
+func (ModField[M]) Inv(a ModInt[M]) (ModInt[M], error) { return a.Inv() }
+func (ModField[M]) IsZero(a ModInt[M]) bool            { return a.v == 0 }
+
+// FloatField は 浮動小数点数による実数体である. 絶対値が Eps 以下の値を零元とみなす.
+type FloatField[T Floats] struct {
+	Eps T
+}
+
+func (FloatField[T]) Zero() T      { return 0 }
+func (FloatField[T]) One() T       { return 1 }
+func (FloatField[T]) Add(a, b T) T { return a + b }
+func (FloatField[T]) Sub(a, b T) T { return a - b }
+func (FloatField[T]) Mul(a, b T) T { return a * b }
+
+func (f FloatField[T]) IsZero(a T) bool {
+	return a <= f.Eps && -a <= f.Eps
+}
+
+// Less は |a| < |b| であるかを判定する. 消去法で絶対値最大のピボットを選ぶために用いられる
+func (FloatField[T]) Less(a, b T) bool {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	return a < b
+}
+
+func (f FloatField[T]) Inv(a T) (T, error) {
+	if f.IsZero(a) {
+		return 0, errors.ErrInvalidValue
+	}
+	return 1 / a, nil
+}
+
+// MinPlus は 加法を min, 乗法を + とする (min, +) 半環である. Inf を加法の単位元 (到達不能) として扱う.
+// 行列累乗により 辺数を固定した最短路を求める用途に用いる.
+type MinPlus[T Ints | Floats] struct {
+	Inf T
+}
+
+func (s MinPlus[T]) Zero() T { return s.Inf }
+func (MinPlus[T]) One() T    { return 0 }
+
+func (MinPlus[T]) Add(a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (s MinPlus[T]) Mul(a, b T) T {
+	if a == s.Inf || b == s.Inf {
+		return s.Inf
+	}
+	return a + b
+}
+
+// XorAnd は 加法を xor, 乗法を and とする半環である. 各ビットを独立な GF(2) の元として扱う.
+type XorAnd[T Ints] struct{}
+
+func (XorAnd[T]) Zero() T      { return 0 }
+func (XorAnd[T]) One() T       { return ^T(0) }
+func (XorAnd[T]) Add(a, b T) T { return a ^ b }
+func (XorAnd[T]) Mul(a, b T) T { return a & b }
+
+// GF2 は 0 と 1 からなる 2 元体であり, 加法は xor, 乗法は and である. 成分は 0 あるいは 1 でなくてはならない.
+// XorAnd と異なり体であるため, xor に関する連立一次方程式・階数・逆行列の計算に用いることができる.
+type GF2[T Ints] struct{}
+
+func (GF2[T]) Zero() T         { return 0 }
+func (GF2[T]) One() T          { return 1 }
+func (GF2[T]) Add(a, b T) T    { return a ^ b }
+func (GF2[T]) Sub(a, b T) T    { return a ^ b }
+func (GF2[T]) Mul(a, b T) T    { return a & b }
+func (GF2[T]) IsZero(a T) bool { return a == 0 }
+
+func (GF2[T]) Inv(a T) (T, error) {
+	if a == 0 {
+		return 0, errors.ErrInvalidValue
+	}
+	return 1, nil
+}
+
+// Matrix は 半環 Semiring[T] 上の行列である.
+type Matrix[T any] struct {
+	rows, cols int
+	data       [][]T
+	sr         Semiring[T]
+}
+
+// NewMatrix は 半環 sr 上の rows 行 cols 列の零行列を返す.
+// rows, cols は非負でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(rows * cols)
+func NewMatrix[T any](sr Semiring[T], rows, cols int) (*Matrix[T], error) {
+	if rows < 0 || cols < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	m := &Matrix[T]{rows: rows, cols: cols, data: make([][]T, rows), sr: sr}
+	for i := range m.data {
+		m.data[i] = make([]T, cols)
+		for j := range m.data[i] {
+			m.data[i][j] = sr.Zero()
+		}
+	}
+	return m, nil
+}
+
+// NewMatrixFromSlice は 半環 sr 上の 成分が data である行列を返す. data はコピーされる.
+// data の各行の長さは等しくなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(rows * cols)
+func NewMatrixFromSlice[T any](sr Semiring[T], data [][]T) (*Matrix[T], error) {
+	cols := 0
+	if len(data) > 0 {
+		cols = len(data[0])
+	}
+	m, err := NewMatrix(sr, len(data), cols)
+	if err != nil {
+		return nil, err
+	}
+	for i := range data {
+		if len(data[i]) != cols {
+			return nil, errors.ErrInvalidValue
+		}
+		copy(m.data[i], data[i])
+	}
+	return m, nil
+}
+
+// Identity は 半環 sr 上の n 次単位行列を返す.
+// n は非負でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^2)
+func Identity[T any](sr Semiring[T], n int) (*Matrix[T], error) {
+	m, err := NewMatrix(sr, n, n)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		m.data[i][i] = sr.One()
+	}
+	return m, nil
+}
+
+// Rows は 行数を返す
+// Time: O(1)
+func (m *Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Cols は 列数を返す
+// Time: O(1)
+func (m *Matrix[T]) Cols() int {
+	return m.cols
+}
+
+// At は (i, j) 成分と error 値 nil を返す.
+// (i, j) が範囲外の場合は ErrInvalidIndex が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (m *Matrix[T]) At(i, j int) (T, error) {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		return m.sr.Zero(), errors.ErrInvalidIndex
+	}
+	return m.data[i][j], nil
+}
+
+// Set は (i, j) 成分を value に変更する.
+// (i, j) が範囲外の場合は ErrInvalidIndex が error 値として返され,操作は棄却される.
+// Time: O(1)
+func (m *Matrix[T]) Set(i, j int, value T) error {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		return errors.ErrInvalidIndex
+	}
+	m.data[i][j] = value
+	return nil
+}
+
+// Clone は m の複製を返す
+// Time: O(rows * cols)
+func (m *Matrix[T]) Clone() *Matrix[T] {
+	res, _ := NewMatrixFromSlice(m.sr, m.data)
+	res.rows, res.cols = m.rows, m.cols
+	return res
+}
+
+// Add は m + other と error 値 nil を返す.
+// 行数・列数が一致しない場合は ErrInvalidValue が error 値として返される.
+// Time: O(rows * cols)
+func (m *Matrix[T]) Add(other *Matrix[T]) (*Matrix[T], error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, errors.ErrInvalidValue
+	}
+	res := m.Clone()
+	for i := range res.data {
+		for j := range res.data[i] {
+			res.data[i][j] = m.sr.Add(res.data[i][j], other.data[i][j])
+		}
+	}
+	return res, nil
+}
+
+// Mul は m * other と error 値 nil を返す.
+// m の列数と other の行数が一致しない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^3)
+func (m *Matrix[T]) Mul(other *Matrix[T]) (*Matrix[T], error) {
+	if m.cols != other.rows {
+		return nil, errors.ErrInvalidValue
+	}
+	res, err := NewMatrix(m.sr, m.rows, other.cols)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < m.rows; i++ {
+		for k := 0; k < m.cols; k++ {
+			a := m.data[i][k]
+			for j := 0; j < other.cols; j++ {
+				res.data[i][j] = m.sr.Add(res.data[i][j], m.sr.Mul(a, other.data[k][j]))
+			}
+		}
+	}
+	return res, nil
+}
+
+// Pow は m の n 乗と error 値 nil を返す. m の 0 乗は単位行列である.
+// m が正方行列でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^3 log n)
+func (m *Matrix[T]) Pow(n uint64) (*Matrix[T], error) {
+	if m.rows != m.cols {
+		return nil, errors.ErrInvalidValue
+	}
+	res, err := Identity(m.sr, m.rows)
+	if err != nil {
+		return nil, err
+	}
+	for base := m; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			if res, err = res.Mul(base); err != nil {
+				return nil, err
+			}
+		}
+		if n > 1 {
+			if base, err = base.Mul(base); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// field は m の成分が属する半環が体であれば その Field[T] を返す
+func (m *Matrix[T]) field() (Field[T], error) {
+	f, ok := m.sr.(Field[T])
+	if !ok {
+		return nil, errors.ErrInvalidValue
+	}
+	return f, nil
+}
+
+// eliminate は a を Gauss-Jordan の消去法により簡約化し, 階数と 行の入れ替え回数の偶奇, ピボットの積を返す.
+// 先頭 cols 列のみをピボットの候補とする.
+func eliminate[T any](f Field[T], a [][]T, cols int) (rank int, odd bool, det T) {
+	det = f.One()
+	pv, ordered := f.(pivoter[T])
+	for col := 0; col < cols && rank < len(a); col++ {
+		pivot := -1
+		for i := rank; i < len(a); i++ {
+			if f.IsZero(a[i][col]) {
+				continue
+			}
+			if pivot < 0 || ordered && pv.Less(a[pivot][col], a[i][col]) {
+				pivot = i
+			}
+			if !ordered {
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		if pivot != rank {
+			a[pivot], a[rank] = a[rank], a[pivot]
+			odd = !odd
+		}
+		det = f.Mul(det, a[rank][col])
+		inv, _ := f.Inv(a[rank][col])
+		for j := range a[rank] {
+			a[rank][j] = f.Mul(a[rank][j], inv)
+		}
+		// 丸め誤差によらず ピボット列が単位ベクトルとなるようにする
+		a[rank][col] = f.One()
+		for i := range a {
+			if i == rank || f.IsZero(a[i][col]) {
+				continue
+			}
+			c := a[i][col]
+			for j := range a[i] {
+				a[i][j] = f.Sub(a[i][j], f.Mul(c, a[rank][j]))
+			}
+			a[i][col] = f.Zero()
+		}
+		rank++
+	}
+	return rank, odd, det
+}
+
+// Determinant は m の行列式と error 値 nil を返す.
+// m が正方行列でない場合, あるいは成分の半環が体 (Field[T]) でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^3)
+func (m *Matrix[T]) Determinant() (T, error) {
+	f, err := m.field()
+	if err != nil || m.rows != m.cols {
+		return m.sr.Zero(), errors.ErrInvalidValue
+	}
+	a := m.Clone().data
+	rank, odd, det := eliminate(f, a, m.cols)
+	if rank < m.rows {
+		return f.Zero(), nil
+	}
+	if odd {
+		det = f.Sub(f.Zero(), det)
+	}
+	return det, nil
+}
+
+// Rank は m の階数と error 値 nil を返す.
+// 成分の半環が体 (Field[T]) でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^3)
+func (m *Matrix[T]) Rank() (int, error) {
+	f, err := m.field()
+	if err != nil {
+		return 0, err
+	}
+	rank, _, _ := eliminate(f, m.Clone().data, m.cols)
+	return rank, nil
+}
+
+// Inverse は m の逆行列と error 値 nil を返す.
+// m が正方行列でない場合, 成分の半環が体 (Field[T]) でない場合, あるいは m が正則でない場合は
+// ErrInvalidValue が error 値として返される.
+// Time: O(N^3)
+func (m *Matrix[T]) Inverse() (*Matrix[T], error) {
+	f, err := m.field()
+	if err != nil || m.rows != m.cols {
+		return nil, errors.ErrInvalidValue
+	}
+	n := m.rows
+	a := make([][]T, n)
+	for i := range a {
+		a[i] = make([]T, 2*n)
+		copy(a[i], m.data[i])
+		for j := n; j < 2*n; j++ {
+			a[i][j] = f.Zero()
+		}
+		a[i][n+i] = f.One()
+	}
+	if rank, _, _ := eliminate(f, a, n); rank < n {
+		return nil, errors.ErrInvalidValue
+	}
+	res, err := NewMatrix(m.sr, n, n)
+	if err != nil {
+		return nil, err
+	}
+	for i := range a {
+		copy(res.data[i], a[i][n:])
+	}
+	return res, nil
+}
+
+// Solve は 連立一次方程式 m x = b の解の 1 つ x と error 値 nil を返す.
+// 解が複数存在する場合は 自由変数を 0 とした解を返す.
+// 成分の半環が体 (Field[T]) でない場合, len(b) が m の行数と一致しない場合, あるいは解が存在しない場合は
+// ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N^3)
+func (m *Matrix[T]) Solve(b []T) ([]T, error) {
+	f, err := m.field()
+	if err != nil || len(b) != m.rows {
+		return nil, errors.ErrInvalidValue
+	}
+	a := make([][]T, m.rows)
+	for i := range a {
+		a[i] = make([]T, m.cols+1)
+		copy(a[i], m.data[i])
+		a[i][m.cols] = b[i]
+	}
+	rank, _, _ := eliminate(f, a, m.cols)
+	for i := rank; i < m.rows; i++ {
+		if !f.IsZero(a[i][m.cols]) {
+			return nil, errors.ErrInvalidValue
+		}
+	}
+	x := make([]T, m.cols)
+	for j := range x {
+		x[j] = f.Zero()
+	}
+	for i := 0; i < rank; i++ {
+		for j := 0; j < m.cols; j++ {
+			if !f.IsZero(a[i][j]) {
+				x[j] = a[i][m.cols]
+				break
+			}
+		}
+	}
+	return x, nil
+}
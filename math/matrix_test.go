@@ -0,0 +1,403 @@
+package math
+
+import (
+	stdmath "math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+type mint = ModInt[Mod998244353]
+
+func modMatrix(t *testing.T, data [][]int) *Matrix[mint] {
+	t.Helper()
+	rows := make([][]mint, len(data))
+	for i := range data {
+		rows[i] = make([]mint, len(data[i]))
+		for j := range data[i] {
+			rows[i][j] = NewModInt[Mod998244353](data[i][j])
+		}
+	}
+	m, err := NewMatrixFromSlice[mint](ModField[Mod998244353]{}, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestMatrixPow(t *testing.T) {
+	// Fibonacci 数
+	m := modMatrix(t, [][]int{{1, 1}, {1, 0}})
+	testCases := []struct {
+		name string
+		n    uint64
+		exp  int
+	}{
+		{name: "Zero", n: 0, exp: 0},
+		{name: "One", n: 1, exp: 1},
+		{name: "Ten", n: 10, exp: 55},
+		{name: "Ninety", n: 90, exp: int(2880067194370816120 % 998244353)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := m.Pow(tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out, err := p.At(0, 1); err != nil {
+				t.Fatal(err)
+			} else if out.Val() != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, out.Val())
+			}
+		})
+	}
+
+	if _, err := modMatrix(t, [][]int{{1, 2}}).Pow(2); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestMatrixMinPlus(t *testing.T) {
+	const inf = stdmath.MaxInt64
+	sr := MinPlus[int]{Inf: inf}
+	// 有向グラフ 0 -> 1 (1), 1 -> 2 (2), 0 -> 2 (10), 2 -> 0 (1)
+	m, err := NewMatrixFromSlice[int](sr, [][]int{{inf, 1, 10}, {inf, inf, 2}, {1, inf, inf}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := m.Pow(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// ちょうど 2 辺を通る最短路
+	exp := [][]int{{11, inf, 3}, {3, inf, inf}, {inf, 2, 11}}
+	for i := range exp {
+		for j := range exp[i] {
+			if out, _ := p.At(i, j); out != exp[i][j] {
+				t.Errorf("(%d, %d): Expected %d, got %d instead\n", i, j, exp[i][j], out)
+			}
+		}
+	}
+
+	if _, err := m.Determinant(); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestMatrixXorAnd(t *testing.T) {
+	m, err := NewMatrixFromSlice[uint8](XorAnd[uint8]{}, [][]uint8{{0b11, 0b01}, {0b10, 0b11}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := m.Mul(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := [][]uint8{{0b11 ^ 0b00, 0b01 ^ 0b01}, {0b10 ^ 0b10, 0b00 ^ 0b11}}
+	for i := range exp {
+		for j := range exp[i] {
+			if out, _ := p.At(i, j); out != exp[i][j] {
+				t.Errorf("(%d, %d): Expected %b, got %b instead\n", i, j, exp[i][j], out)
+			}
+		}
+	}
+}
+
+func TestMatrixGF2(t *testing.T) {
+	f := GF2[uint8]{}
+	testCases := []struct {
+		name string
+		data [][]uint8
+		det  uint8
+		rank int
+	}{
+		{name: "Identity", data: [][]uint8{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}, det: 1, rank: 3},
+		// 実数上では正則だが GF(2) 上では 1 行目と 2 行目の xor が 3 行目となる
+		{name: "XorDependent", data: [][]uint8{{1, 1, 0}, {0, 1, 1}, {1, 0, 1}}, det: 0, rank: 2},
+		{name: "Permutation", data: [][]uint8{{0, 1, 0}, {0, 0, 1}, {1, 0, 0}}, det: 1, rank: 3},
+		{name: "Zero", data: [][]uint8{{0, 0}, {0, 0}}, det: 0, rank: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatrixFromSlice[uint8](f, tc.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if det, err := m.Determinant(); err != nil || det != tc.det {
+				t.Errorf("Determinant: Expected %d, got %d (%v) instead\n", tc.det, det, err)
+			}
+			if rank, err := m.Rank(); err != nil || rank != tc.rank {
+				t.Errorf("Rank: Expected %d, got %d (%v) instead\n", tc.rank, rank, err)
+			}
+		})
+	}
+
+	// 乱択した連立一次方程式の解が 方程式を満たすことを確かめる
+	rng := rand.New(rand.NewSource(2))
+	const n = 8
+	for iter := 0; iter < 50; iter++ {
+		a, x := make([][]uint8, n), make([]uint8, n)
+		for i := range a {
+			a[i] = make([]uint8, n)
+			for j := range a[i] {
+				a[i][j] = uint8(rng.Intn(2))
+			}
+			x[i] = uint8(rng.Intn(2))
+		}
+		b := make([]uint8, n)
+		for i := range a {
+			for j := range a[i] {
+				b[i] ^= a[i][j] & x[j]
+			}
+		}
+		m, err := NewMatrixFromSlice[uint8](f, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := m.Solve(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range a {
+			var v uint8
+			for j := range a[i] {
+				v ^= a[i][j] & out[j]
+			}
+			if v != b[i] {
+				t.Fatalf("row %d: Expected %d, got %d instead\n", i, b[i], v)
+			}
+		}
+		inv, err := m.Inverse()
+		if rank, _ := m.Rank(); rank < n {
+			if err != errors.ErrInvalidValue {
+				t.Fatalf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := m.Mul(inv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id, _ := Identity[uint8](f, n)
+		if !reflect.DeepEqual(p, id) {
+			t.Fatalf("m * m^{-1} should be identity\n")
+		}
+	}
+
+	m, _ := NewMatrixFromSlice[uint8](f, [][]uint8{{1, 1}, {1, 1}})
+	if _, err := m.Solve([]uint8{0, 1}); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	// XorAnd は体でないため 消去法は用いることができない
+	xm, _ := NewMatrixFromSlice[uint8](XorAnd[uint8]{}, [][]uint8{{1, 0}, {0, 1}})
+	if _, err := xm.Rank(); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestMatrixDeterminantRank(t *testing.T) {
+	testCases := []struct {
+		name string
+		data [][]int
+		det  int
+		rank int
+	}{
+		{
+			name: "Identity",
+			data: [][]int{{1, 0}, {0, 1}},
+			det:  1,
+			rank: 2,
+		},
+		{
+			name: "Swap",
+			data: [][]int{{0, 1}, {1, 0}},
+			det:  998244352,
+			rank: 2,
+		},
+		{
+			name: "Singular",
+			data: [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			det:  0,
+			rank: 2,
+		},
+		{
+			name: "General",
+			data: [][]int{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}},
+			det:  49,
+			rank: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := modMatrix(t, tc.data)
+			if det, err := m.Determinant(); err != nil {
+				t.Fatal(err)
+			} else if det.Val() != tc.det {
+				t.Errorf("Determinant: Expected %d, got %d instead\n", tc.det, det.Val())
+			}
+			if rank, err := m.Rank(); err != nil {
+				t.Fatal(err)
+			} else if rank != tc.rank {
+				t.Errorf("Rank: Expected %d, got %d instead\n", tc.rank, rank)
+			}
+		})
+	}
+
+	if rank, err := modMatrix(t, [][]int{{1, 2, 3}, {2, 4, 6}}).Rank(); err != nil || rank != 1 {
+		t.Errorf("Expected %d, got %d (%v) instead\n", 1, rank, err)
+	}
+}
+
+func TestMatrixInverse(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const n = 6
+	data := make([][]int, n)
+	for i := range data {
+		data[i] = make([]int, n)
+		for j := range data[i] {
+			data[i][j] = rng.Intn(100)
+		}
+	}
+	m := modMatrix(t, data)
+	inv, err := m.Inverse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := m.Mul(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := Identity[mint](ModField[Mod998244353]{}, n)
+	if !reflect.DeepEqual(p, id) {
+		t.Errorf("m * m^{-1} should be identity\n")
+	}
+
+	if _, err := modMatrix(t, [][]int{{1, 2}, {2, 4}}).Inverse(); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestMatrixSolve(t *testing.T) {
+	f := FloatField[float64]{Eps: 1e-9}
+	testCases := []struct {
+		name string
+		a    [][]float64
+		b    []float64
+		err  error
+	}{
+		{
+			name: "Unique",
+			a:    [][]float64{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}},
+			b:    []float64{8, -11, -3},
+		},
+		{
+			name: "Underdetermined",
+			a:    [][]float64{{1, 1, 1}, {0, 1, 2}},
+			b:    []float64{6, 5},
+		},
+		{
+			name: "Inconsistent",
+			a:    [][]float64{{1, 1}, {2, 2}},
+			b:    []float64{1, 3},
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "LengthMismatch",
+			a:    [][]float64{{1, 1}, {2, 2}},
+			b:    []float64{1},
+			err:  errors.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatrixFromSlice[float64](f, tc.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			x, err := m.Solve(tc.b)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			for i := range tc.a {
+				sum := 0.0
+				for j := range x {
+					sum += tc.a[i][j] * x[j]
+				}
+				if stdmath.Abs(sum-tc.b[i]) > 1e-9 {
+					t.Errorf("row %d: Expected %v, got %v instead\n", i, tc.b[i], sum)
+				}
+			}
+		})
+	}
+}
+
+func TestMatrixSolvePivoting(t *testing.T) {
+	// 先頭の成分が非常に小さい場合でも 絶対値最大のピボットを選ぶことで正しい解が得られる
+	testCases := []struct {
+		name string
+		eps  float64
+		a    [][]float64
+		b    []float64
+		want []float64
+	}{
+		{name: "1e-17", eps: 0, a: [][]float64{{1e-17, 1}, {1, 1}}, b: []float64{1, 2}, want: []float64{1, 1}},
+		{name: "1e-15", eps: 1e-20, a: [][]float64{{1e-15, 1}, {1, 1}}, b: []float64{1, 2}, want: []float64{1, 1}},
+		{name: "3x3", eps: 0, a: [][]float64{{1e-18, 1, 1}, {1, 1e-18, 1}, {1, 1, 1e-18}}, b: []float64{2, 2, 2}, want: []float64{1, 1, 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatrixFromSlice[float64](FloatField[float64]{Eps: tc.eps}, tc.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			x, err := m.Solve(tc.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range tc.want {
+				if stdmath.Abs(x[i]-tc.want[i]) > 1e-9 {
+					t.Errorf("Expected %v, got %v instead\n", tc.want, x)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestMatrixIndex(t *testing.T) {
+	m, err := NewMatrix[int](MinPlus[int]{Inf: 1 << 60}, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Rows() != 2 || m.Cols() != 3 {
+		t.Errorf("Expected (2, 3), got (%d, %d) instead\n", m.Rows(), m.Cols())
+	}
+	if err := m.Set(1, 2, 5); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := m.At(1, 2); err != nil || out != 5 {
+		t.Errorf("Expected %d, got %d (%v) instead\n", 5, out, err)
+	}
+	if _, err := m.At(2, 0); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+	}
+	if err := m.Set(0, -1, 0); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+	}
+	if _, err := NewMatrixFromSlice[int](MinPlus[int]{}, [][]int{{1, 2}, {3}}); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
@@ -0,0 +1,302 @@
+package math
+
+import (
+	"math/bits"
+	"strconv"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Modulus は ModInt の法を型として表すためのインターフェースである.
+// Mod は [1, 2^31) の範囲の値を返さなくてはならない.
+type Modulus interface {
+	Mod() uint32
+}
+
+// Mod998244353 は 法 998244353 を表す
+type Mod998244353 struct{}
+
+// Mod は 998244353 を返す
+func (Mod998244353) Mod() uint32 { return 998244353 }
+
+// Mod1000000007 は 法 1000000007 を表す
+type Mod1000000007 struct{}
+
+// Mod は 1000000007 を返す
+func (Mod1000000007) Mod() uint32 { return 1000000007 }
+
+// ModInt は 型パラメータ M により法が静的に定まる 剰余環 Z/MZ の元である.
+// ゼロ値は 0 を表す.
+type ModInt[M Modulus] struct {
+	v uint32
+}
+
+// NewModInt は value を法 M で割った余り (>= 0) を表す ModInt[M] を返す.
+// <ex>
+//
+//	x := math.NewModInt[math.Mod998244353](-1) // 998244352
+//
+// Time: O(1)
+func NewModInt[M Modulus, T Ints](value T) ModInt[M] {
+	var m M
+	return ModInt[M]{v: reduce(value, m.Mod())}
+}
+
+// Mod は 法を返す
+// Time: O(1)
+func (a ModInt[M]) Mod() uint32 {
+	var m M
+	return m.Mod()
+}
+
+// Val は a を表す [0, Mod) の整数を返す
+// Time: O(1)
+func (a ModInt[M]) Val() int {
+	return int(a.v)
+}
+
+// Add は a + b を返す
+// Time: O(1)
+func (a ModInt[M]) Add(b ModInt[M]) ModInt[M] {
+	v := a.v + b.v
+	if m := a.Mod(); v >= m {
+		v -= m
+	}
+	return ModInt[M]{v: v}
+}
+
+// Sub は a - b を返す
+// Time: O(1)
+func (a ModInt[M]) Sub(b ModInt[M]) ModInt[M] {
+	v := a.v - b.v
+	if a.v < b.v {
+		v += a.Mod()
+	}
+	return ModInt[M]{v: v}
+}
+
+// Neg は -a を返す
+// Time: O(1)
+func (a ModInt[M]) Neg() ModInt[M] {
+	return ModInt[M]{}.Sub(a)
+}
+
+// Mul は a * b を返す
+// Time: O(1)
+func (a ModInt[M]) Mul(b ModInt[M]) ModInt[M] {
+	return ModInt[M]{v: uint32(uint64(a.v) * uint64(b.v) % uint64(a.Mod()))}
+}
+
+// Pow は a の n 乗を返す. 0 の 0 乗は 1 として扱う.
+// Time: O(log n)
+func (a ModInt[M]) Pow(n uint64) ModInt[M] {
+	res := NewModInt[M](1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			res = res.Mul(a)
+		}
+		a = a.Mul(a)
+	}
+	return res
+}
+
+// Inv は a の乗法逆元と error 値 nil を返す.
+// a と法が互いに素でない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log Mod)
+func (a ModInt[M]) Inv() (ModInt[M], error) {
+	v, err := invMod(int64(a.v), int64(a.Mod()))
+	if err != nil {
+		return ModInt[M]{}, err
+	}
+	return ModInt[M]{v: uint32(v)}, nil
+}
+
+// Div は a / b と error 値 nil を返す.
+// b と法が互いに素でない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log Mod)
+func (a ModInt[M]) Div(b ModInt[M]) (ModInt[M], error) {
+	inv, err := b.Inv()
+	if err != nil {
+		return ModInt[M]{}, err
+	}
+	return a.Mul(inv), nil
+}
+
+// String は a を 10 進表記した文字列を返す
+func (a ModInt[M]) String() string {
+	return strconv.FormatUint(uint64(a.v), 10)
+}
+
+// ModContext は 実行時に定まる法と Barrett reduction のための前計算値を保持する.
+type ModContext struct {
+	m  uint32
+	im uint64
+}
+
+// NewModContext は 法を mod とする ModContext を返す.
+// mod は [1, 2^31) の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(1)
+func NewModContext(mod uint32) (*ModContext, error) {
+	if mod == 0 || mod >= 1<<31 {
+		return nil, errors.ErrInvalidValue
+	}
+	return &ModContext{m: mod, im: ^uint64(0)/uint64(mod) + 1}, nil
+}
+
+// Mod は 法を返す
+// Time: O(1)
+func (c *ModContext) Mod() uint32 {
+	return c.m
+}
+
+// New は value を法で割った余り (>= 0) を表す DynamicModInt を返す
+// Time: O(1)
+func (c *ModContext) New(value int64) DynamicModInt {
+	return DynamicModInt{v: reduce(value, c.m), ctx: c}
+}
+
+// mul は a * b mod m を Barrett reduction により計算する
+func (c *ModContext) mul(a, b uint32) uint32 {
+	if c.m == 1 {
+		return 0
+	}
+	z := uint64(a) * uint64(b)
+	x, _ := bits.Mul64(z, c.im)
+	y := x * uint64(c.m)
+	v := uint32(z - y)
+	if z < y {
+		v += c.m
+	}
+	return v
+}
+
+// DynamicModInt は 実行時に定まる法 (ModContext) による 剰余環の元である.
+// 演算を行う DynamicModInt 同士は 同じ ModContext から生成されていなくてはならない.
+type DynamicModInt struct {
+	v   uint32
+	ctx *ModContext
+}
+
+// Mod は 法を返す
+// Time: O(1)
+func (a DynamicModInt) Mod() uint32 {
+	return a.ctx.m
+}
+
+// Val は a を表す [0, Mod) の整数を返す
+// Time: O(1)
+func (a DynamicModInt) Val() int {
+	return int(a.v)
+}
+
+// Add は a + b を返す
+// Time: O(1)
+func (a DynamicModInt) Add(b DynamicModInt) DynamicModInt {
+	v := a.v + b.v
+	if v >= a.ctx.m {
+		v -= a.ctx.m
+	}
+	return DynamicModInt{v: v, ctx: a.ctx}
+}
+
+// Sub は a - b を返す
+// Time: O(1)
+func (a DynamicModInt) Sub(b DynamicModInt) DynamicModInt {
+	v := a.v - b.v
+	if a.v < b.v {
+		v += a.ctx.m
+	}
+	return DynamicModInt{v: v, ctx: a.ctx}
+}
+
+// Neg は -a を返す
+// Time: O(1)
+func (a DynamicModInt) Neg() DynamicModInt {
+	return DynamicModInt{ctx: a.ctx}.Sub(a)
+}
+
+// Mul は a * b を返す
+// Time: O(1)
+func (a DynamicModInt) Mul(b DynamicModInt) DynamicModInt {
+	return DynamicModInt{v: a.ctx.mul(a.v, b.v), ctx: a.ctx}
+}
+
+// Pow は a の n 乗を返す. 0 の 0 乗は 1 として扱う.
+// Time: O(log n)
+func (a DynamicModInt) Pow(n uint64) DynamicModInt {
+	res := a.ctx.New(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			res = res.Mul(a)
+		}
+		a = a.Mul(a)
+	}
+	return res
+}
+
+// Inv は a の乗法逆元と error 値 nil を返す.
+// a と法が互いに素でない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log Mod)
+func (a DynamicModInt) Inv() (DynamicModInt, error) {
+	v, err := invMod(int64(a.v), int64(a.ctx.m))
+	if err != nil {
+		return DynamicModInt{ctx: a.ctx}, err
+	}
+	return DynamicModInt{v: uint32(v), ctx: a.ctx}, nil
+}
+
+// Div は a / b と error 値 nil を返す.
+// b と法が互いに素でない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(log Mod)
+func (a DynamicModInt) Div(b DynamicModInt) (DynamicModInt, error) {
+	inv, err := b.Inv()
+	if err != nil {
+		return DynamicModInt{ctx: a.ctx}, err
+	}
+	return a.Mul(inv), nil
+}
+
+// String は a を 10 進表記した文字列を返す
+func (a DynamicModInt) String() string {
+	return strconv.FormatUint(uint64(a.v), 10)
+}
+
+// reduce は value を m で割った余り (>= 0) を返す
+func reduce[T Ints](value T, m uint32) uint32 {
+	if value < 0 {
+		r := int64(value) % int64(m)
+		if r < 0 {
+			r += int64(m)
+		}
+		return uint32(r)
+	}
+	return uint32(uint64(value) % uint64(m))
+}
+
+// invMod は 拡張ユークリッドの互除法により a の法 m における乗法逆元 ([0, m)) を返す.
+// a と m が互いに素でない場合は ErrInvalidValue を返す.
+func invMod(a, m int64) (int64, error) {
+	a %= m
+	if a < 0 {
+		a += m
+	}
+	b, x, y := m, int64(1), int64(0)
+	for b != 0 {
+		q := a / b
+		a, b = b, a-q*b
+		x, y = y, x-q*y
+	}
+	if a != 1 {
+		return 0, errors.ErrInvalidValue
+	}
+	x %= m
+	if x < 0 {
+		x += m
+	}
+	return x, nil
+}
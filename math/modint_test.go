@@ -0,0 +1,202 @@
+package math
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestNewModInt(t *testing.T) {
+	testCases := []struct {
+		name string
+		arg  int64
+		exp  int
+	}{
+		{
+			name: "Zero",
+			arg:  0,
+			exp:  0,
+		},
+		{
+			name: "Pos",
+			arg:  998244354,
+			exp:  1,
+		},
+		{
+			name: "Neg",
+			arg:  -1,
+			exp:  998244352,
+		},
+		{
+			name: "MinInt64",
+			arg:  -1 << 63,
+			exp:  int((-1<<63)%998244353 + 998244353),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := NewModInt[Mod998244353](tc.arg)
+			if out.Val() != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, out.Val())
+			}
+		})
+	}
+
+	if out := NewModInt[Mod1000000007](uint64(1<<64 - 1)); out.Val() != int(uint64(1<<64-1)%1000000007) {
+		t.Errorf("Expected %d, got %d instead\n", uint64(1<<64-1)%1000000007, out.Val())
+	}
+}
+
+func TestModIntArithmetic(t *testing.T) {
+	const m = 1000000007
+	rng := rand.New(rand.NewSource(1))
+	ctx, err := NewModContext(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		x, y := rng.Int63n(m), rng.Int63n(m)
+		a, b := NewModInt[Mod1000000007](x), NewModInt[Mod1000000007](y)
+		c, d := ctx.New(x), ctx.New(y)
+
+		exp := []int64{(x + y) % m, (x - y + m) % m, x * y % m, (m - x) % m}
+		get := []int{a.Add(b).Val(), a.Sub(b).Val(), a.Mul(b).Val(), a.Neg().Val()}
+		dyn := []int{c.Add(d).Val(), c.Sub(d).Val(), c.Mul(d).Val(), c.Neg().Val()}
+		for j := range exp {
+			if int64(get[j]) != exp[j] || int64(dyn[j]) != exp[j] {
+				t.Fatalf("Expected %d, got (%d, %d) instead\n", exp[j], get[j], dyn[j])
+			}
+		}
+
+		if y == 0 {
+			continue
+		}
+		if q, err := a.Div(b); err != nil {
+			t.Fatal(err)
+		} else if q.Mul(b) != a {
+			t.Fatalf("(%d / %d) * %d should be %d", x, y, y, x)
+		}
+		if q, err := c.Div(d); err != nil {
+			t.Fatal(err)
+		} else if q.Mul(d).Val() != c.Val() {
+			t.Fatalf("(%d / %d) * %d should be %d", x, y, y, x)
+		}
+	}
+}
+
+func TestModIntPow(t *testing.T) {
+	a := NewModInt[Mod998244353](3)
+	if out := a.Pow(998244352); out.Val() != 1 {
+		t.Errorf("Expected %d, got %d instead\n", 1, out.Val())
+	}
+	if out := a.Pow(0); out.Val() != 1 {
+		t.Errorf("Expected %d, got %d instead\n", 1, out.Val())
+	}
+	if out := a.Pow(10); out.Val() != 59049 {
+		t.Errorf("Expected %d, got %d instead\n", 59049, out.Val())
+	}
+
+	ctx, err := NewModContext(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := ctx.New(5).Pow(3); out.Val() != 0 {
+		t.Errorf("Expected %d, got %d instead\n", 0, out.Val())
+	}
+}
+
+func TestModIntInv(t *testing.T) {
+	ctx, err := NewModContext(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name string
+		arg  int64
+		exp  int
+		err  error
+	}{
+		{
+			name: "Coprime",
+			arg:  5,
+			exp:  5,
+		},
+		{
+			name: "Neg",
+			arg:  -1,
+			exp:  11,
+		},
+		{
+			name: "NotCoprime",
+			arg:  4,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "Zero",
+			arg:  0,
+			err:  errors.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ctx.New(tc.arg).Inv()
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && out.Val() != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, out.Val())
+			}
+		})
+	}
+
+	if _, err := NewModContext(0); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := NewModContext(1 << 31); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestModContextMul(t *testing.T) {
+	// Barrett reduction の商の推定が 1 大きくなり 補正が必要となる場合を含めて 直接の計算と比較する
+	corrected := 0
+	for _, m := range []uint32{1, 2, 3, 12, 998244353, 1000000007, 1<<31 - 1, 1<<31 - 19} {
+		ctx, err := NewModContext(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := []uint32{0, 1, 2, m / 2, m - 2, m - 1}
+		for i := uint32(1); i <= 1000; i++ {
+			values = append(values, uint32(uint64(i)*2654435761%uint64(m)))
+		}
+		for _, a := range values {
+			for _, b := range values {
+				if a >= m || b >= m {
+					continue
+				}
+				z := uint64(a) * uint64(b)
+				if x, _ := bits.Mul64(z, ctx.im); x*uint64(m) > z {
+					corrected++
+				}
+				if out, exp := ctx.mul(a, b), uint32(z%uint64(m)); out != exp {
+					t.Fatalf("mod %d: %d * %d: Expected %d, got %d instead\n", m, a, b, exp, out)
+				}
+			}
+		}
+	}
+	if corrected == 0 {
+		t.Errorf("the correction branch was not exercised\n")
+	}
+}
+
+func TestModIntString(t *testing.T) {
+	a := NewModInt[Mod998244353](-2)
+	if out := fmt.Sprintln(a, []ModInt[Mod998244353]{a, a.Neg()}); out != "998244351 [998244351 2]\n" {
+		t.Errorf("Expected %q, got %q instead\n", "998244351 [998244351 2]\n", out)
+	}
+}
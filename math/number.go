@@ -11,6 +11,7 @@ type Floats interface {
 }
 
 // Abs は与引数の絶対値を返す
+// a が T の最小値の場合はオーバーフローにより負の値を返す. 検出が必要な場合は AbsChecked を用いる
 // Time: O(1)
 func Abs[T Ints](a T) T {
 	if a < 0 {
@@ -34,6 +35,7 @@ func Gcd[T Ints](a, b T) T {
 
 // Lcm は与引数 (a,b) の最小公倍数( >= 0)を返す
 // (a,b) = (0,0) の場合は 0 を返す
+// 結果が T で表現できない場合の返り値は不定である. 検出が必要な場合は LcmChecked を用いる
 // Time: O(log N)
 func Lcm[T Ints](a, b T) T {
 	if a < 0 || b < 0 {
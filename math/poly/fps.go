@@ -0,0 +1,220 @@
+package poly
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+)
+
+// Inverse は f * g ≡ 1 (mod x^n) を満たす g の先頭 n 項と error 値 nil を返す.
+// f の定数項は 0 であってはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log N)
+func Inverse[M math.Modulus](f Poly[M], n int) (Poly[M], error) {
+	if len(f) == 0 || n < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	inv, err := f[0].Inv()
+	if err != nil {
+		return nil, errors.ErrInvalidValue
+	}
+	g := Poly[M]{inv}
+	for k := 1; k < n; k <<= 1 {
+		// g <- g * (2 - f * g) mod x^{2k}
+		fg, err := Mul(resize(f[:minInt(len(f), 2*k)], 2*k), g)
+		if err != nil {
+			return nil, err
+		}
+		fg = resize(fg, 2*k)
+		for i := range fg {
+			fg[i] = fg[i].Neg()
+		}
+		fg[0] = fg[0].Add(math.NewModInt[M](2))
+		if g, err = Mul(g, fg); err != nil {
+			return nil, err
+		}
+		g = resize(g, 2*k)
+	}
+	return resize(g, n), nil
+}
+
+// Log は log f の先頭 n 項と error 値 nil を返す.
+// f の定数項は 1 でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log N)
+func Log[M math.Modulus](f Poly[M], n int) (Poly[M], error) {
+	if len(f) == 0 || f[0].Val() != 1 || n < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	if n == 0 {
+		return Poly[M]{}, nil
+	}
+	f = resize(f, n)
+	inv, err := Inverse(f, n)
+	if err != nil {
+		return nil, err
+	}
+	df, err := Mul(Derivative(f), inv)
+	if err != nil {
+		return nil, err
+	}
+	res, err := Integral(resize(df, n-1))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Exp は exp f の先頭 n 項と error 値 nil を返す.
+// f の定数項は 0 でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log N)
+func Exp[M math.Modulus](f Poly[M], n int) (Poly[M], error) {
+	if n < 0 || len(f) > 0 && f[0].Val() != 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	g := Poly[M]{math.NewModInt[M](1)}
+	for k := 1; k < n; k <<= 1 {
+		// g <- g * (1 - log g + f) mod x^{2k}
+		lg, err := Log(g, 2*k)
+		if err != nil {
+			return nil, err
+		}
+		h := Sub(resize(f[:minInt(len(f), 2*k)], 2*k), lg)
+		h[0] = h[0].Add(math.NewModInt[M](1))
+		if g, err = Mul(g, h); err != nil {
+			return nil, err
+		}
+		g = resize(g, 2*k)
+	}
+	return resize(g, n), nil
+}
+
+// Pow は f^k の先頭 n 項と error 値 nil を返す. 0^0 は 1 として扱う.
+// Time: O(N log N)
+func Pow[M math.Modulus](f Poly[M], k uint64, n int) (Poly[M], error) {
+	if n < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	res := make(Poly[M], n)
+	if k == 0 {
+		if n > 0 {
+			res[0] = math.NewModInt[M](1)
+		}
+		return res, nil
+	}
+	d := 0
+	for d < len(f) && f[d].Val() == 0 {
+		d++
+	}
+	if n == 0 || d == len(f) || d > 0 && k >= uint64((n+d-1)/d) {
+		return res, nil
+	}
+	// f = c x^d (1 + g) と表し, f^k = c^k x^{dk} exp(k log(1 + g)) を計算する
+	shift := d * int(k)
+	c := f[d]
+	inv, err := c.Inv()
+	if err != nil {
+		return nil, errors.ErrInvalidValue
+	}
+	g := make(Poly[M], minInt(len(f)-d, n-shift))
+	for i := range g {
+		g[i] = f[d+i].Mul(inv)
+	}
+	lg, err := Log(g, n-shift)
+	if err != nil {
+		return nil, err
+	}
+	km := math.NewModInt[M](k)
+	for i := range lg {
+		lg[i] = lg[i].Mul(km)
+	}
+	eg, err := Exp(lg, n-shift)
+	if err != nil {
+		return nil, err
+	}
+	ck := c.Pow(k)
+	for i := range eg {
+		res[shift+i] = eg[i].Mul(ck)
+	}
+	return res, nil
+}
+
+// Sqrt は g^2 ≡ f (mod x^n) を満たす g の先頭 n 項と error 値 nil を返す.
+// 解が複数存在する場合は そのうちの 1 つを返す.
+// 解が存在しない場合は ErrNotFound が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log N)
+func Sqrt[M math.Modulus](f Poly[M], n int) (Poly[M], error) {
+	if n < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	d := 0
+	for d < len(f) && d < n && f[d].Val() == 0 {
+		d++
+	}
+	if d == len(f) || d >= n {
+		return make(Poly[M], n), nil
+	}
+	if d%2 != 0 {
+		return nil, errors.ErrNotFound
+	}
+	s, err := modSqrt(f[d])
+	if err != nil {
+		return nil, err
+	}
+	m := n - d/2
+	h := f[d:minInt(len(f), d+m)]
+	inv2 := math.NewModInt[M](2)
+	if inv2, err = inv2.Inv(); err != nil {
+		return nil, errors.ErrInvalidValue
+	}
+	g := Poly[M]{s}
+	for k := 1; k < m; k <<= 1 {
+		// g <- (g + h / g) / 2 mod x^{2k}
+		ig, err := Inverse(g, 2*k)
+		if err != nil {
+			return nil, err
+		}
+		hg, err := Mul(resize(h[:minInt(len(h), 2*k)], 2*k), ig)
+		if err != nil {
+			return nil, err
+		}
+		g = Add(g, resize(hg, 2*k))
+		for i := range g {
+			g[i] = g[i].Mul(inv2)
+		}
+	}
+	res := make(Poly[M], n)
+	copy(res[d/2:], g[:m])
+	return res, nil
+}
+
+// modSqrt は Tonelli-Shanks 法により a の平方根の 1 つを返す.
+// 平方根が存在しない場合は ErrNotFound を返す.
+func modSqrt[M math.Modulus](a math.ModInt[M]) (math.ModInt[M], error) {
+	p := uint64(a.Mod())
+	if a.Val() == 0 || p == 2 {
+		return a, nil
+	}
+	if a.Pow((p-1)/2).Val() != 1 {
+		return a, errors.ErrNotFound
+	}
+	q, s := p-1, 0
+	for q%2 == 0 {
+		q, s = q/2, s+1
+	}
+	z := math.NewModInt[M](2)
+	for z.Pow((p-1)/2).Val() == 1 {
+		z = z.Add(math.NewModInt[M](1))
+	}
+	c, x, t, m := z.Pow(q), a.Pow((q+1)/2), a.Pow(q), s
+	for t.Val() != 1 {
+		i, tt := 0, t
+		for tt.Val() != 1 {
+			tt, i = tt.Mul(tt), i+1
+		}
+		b := c.Pow(1 << (m - i - 1))
+		x, c, t, m = x.Mul(b), b.Mul(b), t.Mul(b).Mul(b), i
+	}
+	return x, nil
+}
@@ -0,0 +1,176 @@
+package poly
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+)
+
+// subproductTree は 葉に (x - xs[i]) を持ち, 各節点に子の積を持つ完全二分木である.
+type subproductTree[M math.Modulus] struct {
+	size  int
+	nodes []Poly[M]
+}
+
+func newSubproductTree[M math.Modulus](xs []math.ModInt[M]) (*subproductTree[M], error) {
+	size := 1
+	for size < len(xs) {
+		size <<= 1
+	}
+	t := &subproductTree[M]{size: size, nodes: make([]Poly[M], 2*size)}
+	one := math.NewModInt[M](1)
+	for i := 0; i < size; i++ {
+		if i < len(xs) {
+			t.nodes[size+i] = Poly[M]{xs[i].Neg(), one}
+		} else {
+			t.nodes[size+i] = Poly[M]{one}
+		}
+	}
+	for i := size - 1; i > 0; i-- {
+		var err error
+		if t.nodes[i], err = Mul(t.nodes[2*i], t.nodes[2*i+1]); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// evaluate は 節点 k 以下の各葉 i について f mod (x - xs[i]) を res[i] に書き込む
+func (t *subproductTree[M]) evaluate(k int, f Poly[M], res []math.ModInt[M]) error {
+	if len(f) <= 32 || k >= t.size {
+		// 次数が小さい場合は各点で直接評価する
+		lo, hi := k, k+1
+		for lo < t.size {
+			lo, hi = 2*lo, 2*hi
+		}
+		for i := lo - t.size; i < hi-t.size && i < len(res); i++ {
+			res[i] = f.Eval(t.nodes[t.size+i][0].Neg())
+		}
+		return nil
+	}
+	for _, c := range []int{2 * k, 2*k + 1} {
+		_, r, err := DivMod(f, t.nodes[c])
+		if err != nil {
+			return err
+		}
+		if err := t.evaluate(c, r, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MultipointEvaluation は 各 xs[i] に対する f(xs[i]) を並べたスライスと error 値 nil を返す.
+// Time: O(N log^2 N)
+func MultipointEvaluation[M math.Modulus](f Poly[M], xs []math.ModInt[M]) ([]math.ModInt[M], error) {
+	res := make([]math.ModInt[M], len(xs))
+	if len(xs) == 0 {
+		return res, nil
+	}
+	t, err := newSubproductTree(xs)
+	if err != nil {
+		return nil, err
+	}
+	_, r, err := DivMod(f, t.nodes[1])
+	if err != nil {
+		return nil, err
+	}
+	if err := t.evaluate(1, r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Interpolate は 各 i について f(xs[i]) = ys[i] を満たす 次数 len(xs) 未満の多項式 f と error 値 nil を返す.
+// xs の要素は相異なり, xs と ys の長さは等しくなくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log^2 N)
+func Interpolate[M math.Modulus](xs, ys []math.ModInt[M]) (Poly[M], error) {
+	if len(xs) != len(ys) {
+		return nil, errors.ErrInvalidValue
+	}
+	if len(xs) == 0 {
+		return Poly[M]{}, nil
+	}
+	t, err := newSubproductTree(xs)
+	if err != nil {
+		return nil, err
+	}
+	// w[i] = Π_{j != i} (xs[i] - xs[j]) は 全体の積の導関数の xs[i] における値である
+	w, err := MultipointEvaluation(Derivative(t.nodes[1]), xs)
+	if err != nil {
+		return nil, err
+	}
+	leaves := make([]Poly[M], t.size)
+	for i := range leaves {
+		if i >= len(xs) {
+			leaves[i] = Poly[M]{}
+			continue
+		}
+		inv, err := w[i].Inv()
+		if err != nil {
+			return nil, errors.ErrInvalidValue
+		}
+		leaves[i] = Poly[M]{ys[i].Mul(inv)}
+	}
+	// 節点 k の値は Σ_{葉 i ∈ k} c_i Π_{葉 j ∈ k, j != i} (x - xs[j]) である
+	var combine func(k int) (Poly[M], error)
+	combine = func(k int) (Poly[M], error) {
+		if k >= t.size {
+			return leaves[k-t.size], nil
+		}
+		l, err := combine(2 * k)
+		if err != nil {
+			return nil, err
+		}
+		r, err := combine(2*k + 1)
+		if err != nil {
+			return nil, err
+		}
+		lr, err := Mul(l, t.nodes[2*k+1])
+		if err != nil {
+			return nil, err
+		}
+		rl, err := Mul(r, t.nodes[2*k])
+		if err != nil {
+			return nil, err
+		}
+		return Add(lr, rl), nil
+	}
+	f, err := combine(1)
+	if err != nil {
+		return nil, err
+	}
+	return resize(f, len(xs)), nil
+}
+
+// TaylorShift は g(x) = f(x + c) を満たす多項式 g と error 値 nil を返す.
+// len(f) は 法 M 以下でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N log N)
+func TaylorShift[M math.Modulus](f Poly[M], c math.ModInt[M]) (Poly[M], error) {
+	n := len(f)
+	if n == 0 {
+		return Poly[M]{}, nil
+	}
+	comb, err := combination[M](n - 1)
+	if err != nil {
+		return nil, err
+	}
+	// i! g_i = Σ_j (f_j j!) (c^{j-i} / (j-i)!)
+	a, b := make(Poly[M], n), make(Poly[M], n)
+	pw := math.NewModInt[M](1)
+	for i := 0; i < n; i++ {
+		a[n-1-i] = f[i].Mul(math.NewModInt[M](comb.Fact(i)))
+		b[i] = pw.Mul(math.NewModInt[M](comb.InvFact(i)))
+		pw = pw.Mul(c)
+	}
+	ab, err := Mul(a, b)
+	if err != nil {
+		return nil, err
+	}
+	res := make(Poly[M], n)
+	for i := range res {
+		res[i] = ab[n-1-i].Mul(math.NewModInt[M](comb.InvFact(i)))
+	}
+	return res, nil
+}
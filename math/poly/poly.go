@@ -0,0 +1,164 @@
+package poly
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+)
+
+// Poly は 素数 M を法とする多項式 (形式的冪級数) であり, i 番目の要素が x^i の係数を表す.
+type Poly[M math.Modulus] []math.ModInt[M]
+
+// New は 整数の係数列 coefs から Poly[M] を返す
+// Time: O(N)
+func New[M math.Modulus, T math.Ints](coefs ...T) Poly[M] {
+	res := make(Poly[M], len(coefs))
+	for i, c := range coefs {
+		res[i] = math.NewModInt[M](c)
+	}
+	return res
+}
+
+// Eval は f(x) の値を返す
+// Time: O(N)
+func (f Poly[M]) Eval(x math.ModInt[M]) math.ModInt[M] {
+	var res math.ModInt[M]
+	for i := len(f) - 1; i >= 0; i-- {
+		res = res.Mul(x).Add(f[i])
+	}
+	return res
+}
+
+// Trim は 末尾の 0 である係数を取り除いた多項式を返す. 返り値は f と領域を共有する.
+// Time: O(N)
+func (f Poly[M]) Trim() Poly[M] {
+	n := len(f)
+	for n > 0 && f[n-1].Val() == 0 {
+		n--
+	}
+	return f[:n]
+}
+
+// Add は f + g を返す
+// Time: O(N + M)
+func Add[M math.Modulus](f, g Poly[M]) Poly[M] {
+	res := make(Poly[M], maxInt(len(f), len(g)))
+	copy(res, f)
+	for i := range g {
+		res[i] = res[i].Add(g[i])
+	}
+	return res
+}
+
+// Sub は f - g を返す
+// Time: O(N + M)
+func Sub[M math.Modulus](f, g Poly[M]) Poly[M] {
+	res := make(Poly[M], maxInt(len(f), len(g)))
+	copy(res, f)
+	for i := range g {
+		res[i] = res[i].Sub(g[i])
+	}
+	return res
+}
+
+// Mul は f * g と error 値 nil を返す.
+// 長さに関する条件は math.Convolution と同様であり, 守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O((N + M) log (N + M))
+func Mul[M math.Modulus](f, g Poly[M]) (Poly[M], error) {
+	return math.Convolution(f, g)
+}
+
+// Derivative は f の導関数を返す
+// Time: O(N)
+func Derivative[M math.Modulus](f Poly[M]) Poly[M] {
+	if len(f) == 0 {
+		return Poly[M]{}
+	}
+	res := make(Poly[M], len(f)-1)
+	for i := range res {
+		res[i] = f[i+1].Mul(math.NewModInt[M](i + 1))
+	}
+	return res
+}
+
+// Integral は 定数項を 0 とする f の不定積分と error 値 nil を返す.
+// len(f) は 法 M 未満でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N)
+func Integral[M math.Modulus](f Poly[M]) (Poly[M], error) {
+	c, err := combination[M](len(f))
+	if err != nil {
+		return nil, err
+	}
+	res := make(Poly[M], len(f)+1)
+	for i := range f {
+		res[i+1] = f[i].Mul(math.NewModInt[M](c.Inv(i + 1)))
+	}
+	return res, nil
+}
+
+// DivMod は f を g で割った商 q と余り r, error 値 nil を返す.
+// q と r は末尾の 0 である係数を取り除いた形で返される.
+// g が零多項式の場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(N log N)
+func DivMod[M math.Modulus](f, g Poly[M]) (q, r Poly[M], err error) {
+	f, g = f.Trim(), g.Trim()
+	if len(g) == 0 {
+		return nil, nil, errors.ErrInvalidValue
+	}
+	if len(f) < len(g) {
+		return Poly[M]{}, append(Poly[M]{}, f...), nil
+	}
+	n := len(f) - len(g) + 1
+	rf, rg := reverse(f)[:n], reverse(g)
+	inv, err := Inverse(rg, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	if q, err = Mul(rf, inv); err != nil {
+		return nil, nil, err
+	}
+	q = reverse(q[:n])
+	gq, err := Mul(g, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return q.Trim(), Sub(f, gq)[:len(g)-1].Trim(), nil
+}
+
+// combination は 法 M における [0, n] の階乗テーブルを返す
+func combination[M math.Modulus](n int) (*math.Combination, error) {
+	var m M
+	if uint64(n) >= uint64(m.Mod()) {
+		return nil, errors.ErrInvalidValue
+	}
+	return math.NewCombination(n, int(m.Mod()))
+}
+
+func reverse[M math.Modulus](f Poly[M]) Poly[M] {
+	res := make(Poly[M], len(f))
+	for i := range f {
+		res[len(f)-1-i] = f[i]
+	}
+	return res
+}
+
+// resize は f の先頭 n 項を (足りない場合は 0 で埋めて) 新たな領域にコピーして返す
+func resize[M math.Modulus](f Poly[M], n int) Poly[M] {
+	res := make(Poly[M], n)
+	copy(res, f)
+	return res
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,250 @@
+package poly_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+	poly "github.com/hiden2000/go_ds/math/poly"
+)
+
+type mint = math.ModInt[math.Mod998244353]
+type fps = poly.Poly[math.Mod998244353]
+
+func randomPoly(rng *rand.Rand, n int) fps {
+	res := make(fps, n)
+	for i := range res {
+		res[i] = math.NewModInt[math.Mod998244353](rng.Uint32())
+	}
+	return res
+}
+
+func naiveMul(f, g fps, n int) fps {
+	res := make(fps, n)
+	for i := range f {
+		for j := range g {
+			if i+j < n {
+				res[i+j] = res[i+j].Add(f[i].Mul(g[j]))
+			}
+		}
+	}
+	return res
+}
+
+func TestInverse(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 64, 100, 300} {
+		f := randomPoly(rng, n)
+		f[0] = math.NewModInt[math.Mod998244353](rng.Intn(100) + 1)
+		g, err := poly.Inverse(f, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := make(fps, n)
+		exp[0] = math.NewModInt[math.Mod998244353](1)
+		if out := naiveMul(f, g, n); !reflect.DeepEqual(out, exp) {
+			t.Fatalf("n = %d: f * f^{-1} should be 1\n", n)
+		}
+	}
+
+	if _, err := poly.Inverse(poly.New[math.Mod998244353](0, 1), 3); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestLogExp(t *testing.T) {
+	// exp(x) = Σ x^i / i!
+	c, err := math.NewCombination(10, 998244353)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expX := make(fps, 10)
+	for i := range expX {
+		expX[i] = math.NewModInt[math.Mod998244353](c.InvFact(i))
+	}
+	if out, err := poly.Exp(poly.New[math.Mod998244353](0, 1), 10); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(out, expX) {
+		t.Errorf("Expected %v, got %v instead\n", expX, out)
+	}
+	if out, err := poly.Log(expX, 10); err != nil {
+		t.Fatal(err)
+	} else if exp := poly.New[math.Mod998244353](0, 1, 0, 0, 0, 0, 0, 0, 0, 0); !reflect.DeepEqual(out, exp) {
+		t.Errorf("Expected %v, got %v instead\n", exp, out)
+	}
+
+	// exp(log f) = f
+	rng := rand.New(rand.NewSource(2))
+	for _, n := range []int{1, 3, 17, 200} {
+		f := randomPoly(rng, n)
+		f[0] = math.NewModInt[math.Mod998244353](1)
+		lf, err := poly.Log(f, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := poly.Exp(lf, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(out, f) {
+			t.Fatalf("n = %d: exp(log f) should be f\n", n)
+		}
+	}
+
+	if _, err := poly.Log(poly.New[math.Mod998244353](2, 1), 3); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := poly.Exp(poly.New[math.Mod998244353](1, 1), 3); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestPow(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	testCases := []struct {
+		name  string
+		f     fps
+		k     uint64
+		n     int
+		exact bool
+	}{
+		{name: "Zero", f: poly.New[math.Mod998244353](0, 0), k: 3, n: 5},
+		{name: "ZeroPowZero", f: poly.New[math.Mod998244353](0, 0), k: 0, n: 3},
+		{name: "LeadingZeros", f: poly.New[math.Mod998244353](0, 0, 3, 1, 4), k: 3, n: 12},
+		{name: "ShiftOverflow", f: poly.New[math.Mod998244353](0, 2), k: 1 << 62, n: 10},
+		{name: "Random", f: randomPoly(rng, 30), k: 7, n: 40},
+		{name: "RandomShifted", f: append(fps{{}}, randomPoly(rng, 30)...), k: 5, n: 40},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := poly.Pow(tc.f, tc.k, tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			exp := make(fps, tc.n)
+			if tc.n > 0 {
+				exp[0] = math.NewModInt[math.Mod998244353](1)
+			}
+			for i := uint64(0); i < tc.k && i < 64; i++ {
+				exp = naiveMul(exp, tc.f, tc.n)
+			}
+			if !reflect.DeepEqual(out, exp) {
+				t.Errorf("Expected %v, got %v instead\n", exp, out)
+			}
+		})
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	for _, n := range []int{1, 4, 33, 100} {
+		g := randomPoly(rng, n)
+		g[0] = math.NewModInt[math.Mod998244353](rng.Intn(1000) + 1)
+		f := naiveMul(g, g, n)
+		s, err := poly.Sqrt(f, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out := naiveMul(s, s, n); !reflect.DeepEqual(out, f) {
+			t.Fatalf("n = %d: Sqrt(f)^2 should be f\n", n)
+		}
+	}
+
+	// x^2 * (4 + 4x + x^2) = (x(2 + x))^2
+	if out, err := poly.Sqrt(poly.New[math.Mod998244353](0, 0, 4, 4, 1), 5); err != nil {
+		t.Fatal(err)
+	} else if s := naiveMul(out, out, 5); !reflect.DeepEqual(s, poly.New[math.Mod998244353](0, 0, 4, 4, 1)) {
+		t.Errorf("Sqrt(f)^2 should be f, got %v instead\n", s)
+	}
+
+	testCases := []struct {
+		name string
+		f    fps
+	}{
+		{name: "OddShift", f: poly.New[math.Mod998244353](0, 1)},
+		{name: "NonResidue", f: poly.New[math.Mod998244353](3, 1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := poly.Sqrt(tc.f, 4); err != errors.ErrNotFound {
+				t.Errorf("Expected %v, got %v instead\n", errors.ErrNotFound, err)
+			}
+		})
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for _, size := range [][2]int{{1, 1}, {3, 5}, {10, 3}, {200, 70}, {500, 499}} {
+		f, g := randomPoly(rng, size[0]), randomPoly(rng, size[1])
+		q, r, err := poly.DivMod(f, g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r) >= len(g.Trim()) {
+			t.Fatalf("%v: deg r should be less than deg g\n", size)
+		}
+		gq, err := poly.Mul(g, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out := poly.Add(gq, r).Trim(); !reflect.DeepEqual(out, f.Trim()) {
+			t.Fatalf("%v: g * q + r should be f\n", size)
+		}
+	}
+
+	if _, _, err := poly.DivMod(poly.New[math.Mod998244353](1, 2), poly.New[math.Mod998244353](0, 0)); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestMultipointInterpolate(t *testing.T) {
+	rng := rand.New(rand.NewSource(6))
+	for _, n := range []int{1, 2, 7, 100, 300} {
+		f := randomPoly(rng, n)
+		xs := make([]mint, n)
+		for i := range xs {
+			xs[i] = math.NewModInt[math.Mod998244353](i*i + 3*i + 1)
+		}
+		ys, err := poly.MultipointEvaluation(f, xs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range xs {
+			if ys[i] != f.Eval(xs[i]) {
+				t.Fatalf("n = %d: f(%v): Expected %v, got %v instead\n", n, xs[i], f.Eval(xs[i]), ys[i])
+			}
+		}
+		out, err := poly.Interpolate(xs, ys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(out, f) {
+			t.Fatalf("n = %d: Interpolate should restore f\n", n)
+		}
+	}
+
+	xs := poly.New[math.Mod998244353](1, 2, 1)
+	if _, err := poly.Interpolate(xs, xs); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestTaylorShift(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	f := randomPoly(rng, 50)
+	c := math.NewModInt[math.Mod998244353](12345)
+	g, err := poly.TaylorShift(f, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for x := 0; x < 20; x++ {
+		xm := math.NewModInt[math.Mod998244353](x)
+		if g.Eval(xm) != f.Eval(xm.Add(c)) {
+			t.Fatalf("g(%d) should be f(%d + c)\n", x, x)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package math
+
+import (
+	"math/bits"
+	"sort"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// PrimeFactor は 素因数分解における 素因数 Prime とその指数 Exp の組である.
+type PrimeFactor[T Ints] struct {
+	Prime T
+	Exp   int
+}
+
+// millerRabinBases は 2^64 未満の全ての整数に対して Miller-Rabin 素数判定を決定的にする底である
+var millerRabinBases = []uint64{2, 325, 9375, 28178, 450775, 9780504, 1795265022}
+
+// smallPrimes は 素因数分解の前処理として試し割りを行う素数である
+var smallPrimes = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47}
+
+// IsPrime は 与引数 n が素数であるかを判定する.
+// 決定的な Miller-Rabin 素数判定法を用いるため 64 bit 整数の全域で正しく判定する.
+// Time: O(log N)
+func IsPrime[T Ints](n T) bool {
+	if n < 2 {
+		return false
+	}
+	return isPrime(uint64(n))
+}
+
+func isPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range smallPrimes {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	if n < smallPrimes[len(smallPrimes)-1]*smallPrimes[len(smallPrimes)-1] {
+		return true
+	}
+	s := bits.TrailingZeros64(n - 1)
+	d := (n - 1) >> s
+	for _, a := range millerRabinBases {
+		a %= n
+		if a == 0 {
+			continue
+		}
+		x := powMod(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for i := 1; i < s && composite; i++ {
+			x = mulMod(x, x, n)
+			composite = x != n-1
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// Factorize は 与引数 n を素因数分解し, 素因数の昇順に並べた PrimeFactor[T] のスライスと error 値 nil を返す.
+// n = 1 の場合は空のスライスを返す.
+// n は正でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Pollard の ρ 法 (Brent の変種) を用いる.
+// Time: O(N^(1/4) log N) (expected)
+func Factorize[T Ints](n T) ([]PrimeFactor[T], error) {
+	if n < 1 {
+		return nil, errors.ErrInvalidValue
+	}
+	primes := factorize(uint64(n), []uint64{})
+	sort.Slice(primes, func(i, j int) bool { return primes[i] < primes[j] })
+	res := []PrimeFactor[T]{}
+	for _, p := range primes {
+		if len(res) > 0 && res[len(res)-1].Prime == T(p) {
+			res[len(res)-1].Exp++
+		} else {
+			res = append(res, PrimeFactor[T]{Prime: T(p), Exp: 1})
+		}
+	}
+	return res, nil
+}
+
+// factorize は n の素因数を重複を含めて primes に追加して返す
+func factorize(n uint64, primes []uint64) []uint64 {
+	for _, p := range smallPrimes {
+		for n%p == 0 {
+			primes = append(primes, p)
+			n /= p
+		}
+	}
+	if n == 1 {
+		return primes
+	}
+	if isPrime(n) {
+		return append(primes, n)
+	}
+	d := pollardRho(n)
+	primes = factorize(d, primes)
+	return factorize(n/d, primes)
+}
+
+// pollardRho は 合成数 n の非自明な約数を 1 つ返す
+func pollardRho(n uint64) uint64 {
+	const m = 128
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 {
+			x = mulMod(x, x, n)
+			if x += c; x >= n || x < c {
+				x -= n
+			}
+			return x
+		}
+		x, y, ys, g, q := uint64(0), uint64(2), uint64(0), uint64(1), uint64(1)
+		for r := 1; g == 1; r <<= 1 {
+			x = y
+			for i := 0; i < r; i++ {
+				y = f(y)
+			}
+			for k := 0; k < r && g == 1; k += m {
+				ys = y
+				for i := 0; i < m && i < r-k; i++ {
+					y = f(y)
+					q = mulMod(q, absDiff(x, y), n)
+				}
+				g = Gcd(q, n)
+			}
+		}
+		if g == n {
+			for g = 1; g == 1; {
+				ys = f(ys)
+				g = Gcd(absDiff(x, ys), n)
+			}
+		}
+		if g != n {
+			return g
+		}
+	}
+}
+
+// Divisors は 与引数 n の正の約数を昇順に並べたスライスと error 値 nil を返す.
+// n は正でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^(1/4) log N + d(N) log d(N)) (expected)
+func Divisors[T Ints](n T) ([]T, error) {
+	factors, err := Factorize(n)
+	if err != nil {
+		return nil, err
+	}
+	res := []T{1}
+	for _, f := range factors {
+		size := len(res)
+		pk := T(1)
+		for e := 0; e < f.Exp; e++ {
+			pk *= f.Prime
+			for i := 0; i < size; i++ {
+				res = append(res, res[i]*pk)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res, nil
+}
+
+// Totient は 与引数 n に対する Euler の φ 関数の値 (1 以上 n 以下で n と互いに素な整数の個数) と error 値 nil を返す.
+// n は正でなくてはならず, 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^(1/4) log N) (expected)
+func Totient[T Ints](n T) (T, error) {
+	factors, err := Factorize(n)
+	if err != nil {
+		return 0, err
+	}
+	res := n
+	for _, f := range factors {
+		res = res / f.Prime * (f.Prime - 1)
+	}
+	return res, nil
+}
+
+// powMod は 128 bit の中間値を用いて a^e mod m を返す
+func powMod(a, e, m uint64) uint64 {
+	res := 1 % m
+	for a %= m; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			res = mulMod(res, a, m)
+		}
+		a = mulMod(a, a, m)
+	}
+	return res
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a < b {
+		return b - a
+	}
+	return a - b
+}
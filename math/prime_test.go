@@ -0,0 +1,162 @@
+package math
+
+import (
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestIsPrime(t *testing.T) {
+	// 試し割りとの比較
+	for n := -10; n < 5000; n++ {
+		exp := n >= 2
+		for d := 2; d*d <= n && exp; d++ {
+			exp = n%d != 0
+		}
+		if out := IsPrime(n); out != exp {
+			t.Fatalf("IsPrime(%d): Expected %v, got %v instead\n", n, exp, out)
+		}
+	}
+
+	testCases := []struct {
+		name string
+		arg  uint64
+		exp  bool
+	}{
+		{
+			name: "Mersenne61",
+			arg:  1<<61 - 1,
+			exp:  true,
+		},
+		{
+			name: "LargestUint64Prime",
+			arg:  18446744073709551557,
+			exp:  true,
+		},
+		{
+			name: "MaxUint64",
+			arg:  1<<64 - 1,
+			exp:  false,
+		},
+		{
+			name: "Carmichael",
+			arg:  561,
+			exp:  false,
+		},
+		{
+			name: "StrongPseudoprime",
+			arg:  3825123056546413051,
+			exp:  false,
+		},
+		{
+			name: "SquareOfPrime",
+			arg:  4294967291 * 4294967291,
+			exp:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if out := IsPrime(tc.arg); out != tc.exp {
+				t.Errorf("Expected %v, got %v instead\n", tc.exp, out)
+			}
+		})
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	testCases := []struct {
+		name string
+		arg  uint64
+		exp  []PrimeFactor[uint64]
+	}{
+		{
+			name: "One",
+			arg:  1,
+			exp:  []PrimeFactor[uint64]{},
+		},
+		{
+			name: "Small",
+			arg:  360,
+			exp:  []PrimeFactor[uint64]{{2, 3}, {3, 2}, {5, 1}},
+		},
+		{
+			name: "MaxUint64",
+			arg:  1<<64 - 1,
+			exp:  []PrimeFactor[uint64]{{3, 1}, {5, 1}, {17, 1}, {257, 1}, {641, 1}, {65537, 1}, {6700417, 1}},
+		},
+		{
+			name: "SemiPrime",
+			arg:  4294967291 * 4294967279,
+			exp:  []PrimeFactor[uint64]{{4294967279, 1}, {4294967291, 1}},
+		},
+		{
+			name: "PrimePower",
+			arg:  1000003 * 1000003 * 1000003,
+			exp:  []PrimeFactor[uint64]{{1000003, 3}},
+		},
+		{
+			name: "Prime",
+			arg:  18446744073709551557,
+			exp:  []PrimeFactor[uint64]{{18446744073709551557, 1}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := Factorize(tc.arg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(out, tc.exp) {
+				t.Errorf("Expected %v, got %v instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	if _, err := Factorize(0); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := Factorize(-12); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestDivisorsTotient(t *testing.T) {
+	for n := 1; n <= 500; n++ {
+		expDivisors, expTotient := []int{}, 0
+		for d := 1; d <= n; d++ {
+			if n%d == 0 {
+				expDivisors = append(expDivisors, d)
+			}
+			if Gcd(n, d) == 1 {
+				expTotient++
+			}
+		}
+		if out, err := Divisors(n); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(out, expDivisors) {
+			t.Fatalf("Divisors(%d): Expected %v, got %v instead\n", n, expDivisors, out)
+		}
+		if out, err := Totient(n); err != nil {
+			t.Fatal(err)
+		} else if out != expTotient {
+			t.Fatalf("Totient(%d): Expected %d, got %d instead\n", n, expTotient, out)
+		}
+	}
+
+	if out, err := Totient(int64(1e18)); err != nil {
+		t.Fatal(err)
+	} else if out != 4e17 {
+		t.Errorf("Expected %d, got %d instead\n", int64(4e17), out)
+	}
+}
+
+func BenchmarkFactorize(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := Factorize(uint64(4294967291 * 4294967279)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
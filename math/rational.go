@@ -0,0 +1,230 @@
+package math
+
+import (
+	"math/big"
+	"strings"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Rational は 分子と分母を T で保持する有理数である.
+// 常に既約であり 分母は正に保たれる. ゼロ値は 0 を表す.
+// 内部表現は値ごとに一意であるため, == による比較や map のキーとして用いることができる.
+// 演算結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// 中間値のみがオーバーフローする場合は big.Rat による計算に切り替えて正確な結果を返す.
+type Rational[T Ints] struct {
+	num T
+	dm1 T // 分母 - 1 (ゼロ値が 0/1 を表すように 1 を引いて保持する)
+}
+
+// NewRational は num / den を既約にした Rational[T] と error 値 nil を返す.
+// den = 0 の場合は ErrInvalidValue が, 既約にした結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// Time: O(log N)
+func NewRational[T Ints](num, den T) (Rational[T], error) {
+	if den == 0 {
+		return Rational[T]{}, errors.ErrInvalidValue
+	}
+	an, err1 := AbsChecked(num)
+	ad, err2 := AbsChecked(den)
+	if err1 != nil || err2 != nil {
+		return ratFromBig[T](new(big.Rat).SetFrac(toBigInt(num), toBigInt(den)))
+	}
+	g := Gcd(an, ad)
+	num, den = num/g, den/g
+	if den < 0 {
+		num, den = -num, -den
+	}
+	return Rational[T]{num: num, dm1: den - 1}, nil
+}
+
+// RationalFromRat は x を Rational[T] に変換した値と error 値 nil を返す.
+// x が T で表現できない場合は ErrOverflow が error 値として返される.
+// Time: O(1)
+func RationalFromRat[T Ints](x *big.Rat) (Rational[T], error) {
+	return ratFromBig[T](x)
+}
+
+// ParseRational は "p/q" あるいは "p" の形式の文字列を Rational[T] として読み込んだ値と error 値 nil を返す.
+// 書式が正しくない場合 あるいは q = 0 の場合は ErrInvalidValue が,
+// 既約にした結果が T で表現できない場合は ErrOverflow が error 値として返される.
+// Time: O(|s|)
+func ParseRational[T Ints](s string) (Rational[T], error) {
+	ps, qs, found := strings.Cut(s, "/")
+	p, ok := new(big.Int).SetString(ps, 10)
+	if !ok {
+		return Rational[T]{}, errors.ErrInvalidValue
+	}
+	q := big.NewInt(1)
+	if found {
+		if q, ok = new(big.Int).SetString(qs, 10); !ok || q.Sign() == 0 {
+			return Rational[T]{}, errors.ErrInvalidValue
+		}
+	}
+	return ratFromBig[T](new(big.Rat).SetFrac(p, q))
+}
+
+// Num は 分子を返す
+// Time: O(1)
+func (r Rational[T]) Num() T {
+	return r.num
+}
+
+// Den は 分母 ( > 0) を返す
+// Time: O(1)
+func (r Rational[T]) Den() T {
+	return r.dm1 + 1
+}
+
+// Sign は r の符号 (-1, 0, 1) を返す
+// Time: O(1)
+func (r Rational[T]) Sign() int {
+	switch {
+	case r.num < 0:
+		return -1
+	case r.num > 0:
+		return 1
+	}
+	return 0
+}
+
+// Add は r + other と error 値 nil を返す.
+// Time: O(log N)
+func (r Rational[T]) Add(other Rational[T]) (Rational[T], error) {
+	// a/b + c/d = (a*(d/g) + c*(b/g)) / (b/g*d)
+	b, d := r.Den(), other.Den()
+	g := Gcd(b, d)
+	x, err1 := MulChecked(r.num, d/g)
+	y, err2 := MulChecked(other.num, b/g)
+	num, err3 := AddChecked(x, y)
+	den, err4 := MulChecked(b/g, d)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+		return ratFromBig[T](new(big.Rat).Add(r.Rat(), other.Rat()))
+	}
+	return NewRational(num, den)
+}
+
+// Sub は r - other と error 値 nil を返す.
+// Time: O(log N)
+func (r Rational[T]) Sub(other Rational[T]) (Rational[T], error) {
+	b, d := r.Den(), other.Den()
+	g := Gcd(b, d)
+	x, err1 := MulChecked(r.num, d/g)
+	y, err2 := MulChecked(other.num, b/g)
+	num, err3 := SubChecked(x, y)
+	den, err4 := MulChecked(b/g, d)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+		return ratFromBig[T](new(big.Rat).Sub(r.Rat(), other.Rat()))
+	}
+	return NewRational(num, den)
+}
+
+// Mul は r * other と error 値 nil を返す.
+// Time: O(log N)
+func (r Rational[T]) Mul(other Rational[T]) (Rational[T], error) {
+	// 事前に交差する分子と分母を約分しておくことで 中間値のオーバーフローを抑える
+	an, err1 := AbsChecked(r.num)
+	cn, err2 := AbsChecked(other.num)
+	if err1 != nil || err2 != nil {
+		return ratFromBig[T](new(big.Rat).Mul(r.Rat(), other.Rat()))
+	}
+	g1, g2 := Gcd(an, other.Den()), Gcd(cn, r.Den())
+	num, err1 := MulChecked(r.num/g1, other.num/g2)
+	den, err2 := MulChecked(r.Den()/g2, other.Den()/g1)
+	if err1 != nil || err2 != nil {
+		return ratFromBig[T](new(big.Rat).Mul(r.Rat(), other.Rat()))
+	}
+	return NewRational(num, den)
+}
+
+// Div は r / other と error 値 nil を返す.
+// other = 0 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(log N)
+func (r Rational[T]) Div(other Rational[T]) (Rational[T], error) {
+	if other.num == 0 {
+		return Rational[T]{}, errors.ErrInvalidValue
+	}
+	inv, err := NewRational(other.Den(), other.num)
+	if err != nil {
+		return ratFromBig[T](new(big.Rat).Quo(r.Rat(), other.Rat()))
+	}
+	return r.Mul(inv)
+}
+
+// Compare は r < other の場合 -1 を, r == other の場合 0 を, r > other の場合 1 を返す.
+// Time: O(1)
+func (r Rational[T]) Compare(other Rational[T]) int {
+	x, err1 := MulChecked(r.num, other.Den())
+	y, err2 := MulChecked(other.num, r.Den())
+	if err1 != nil || err2 != nil {
+		return r.Rat().Cmp(other.Rat())
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// Floor は r 以下の最大の整数を返す
+// Time: O(1)
+func (r Rational[T]) Floor() T {
+	return FloorDiv(r.num, r.Den())
+}
+
+// Ceil は r 以上の最小の整数を返す
+// Time: O(1)
+func (r Rational[T]) Ceil() T {
+	return CeilDiv(r.num, r.Den())
+}
+
+// Rat は r を *big.Rat に変換した値を返す
+// Time: O(1)
+func (r Rational[T]) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(toBigInt(r.num), toBigInt(r.Den()))
+}
+
+// Float64 は r に最も近い float64 の値を返す
+// Time: O(1)
+func (r Rational[T]) Float64() float64 {
+	f, _ := r.Rat().Float64()
+	return f
+}
+
+// String は 分母が 1 の場合は "p" を, それ以外の場合は "p/q" を返す.
+// io.CustomIO の Println 等による出力に用いられる.
+func (r Rational[T]) String() string {
+	if r.Den() == 1 {
+		return toBigInt(r.num).String()
+	}
+	return toBigInt(r.num).String() + "/" + toBigInt(r.Den()).String()
+}
+
+// ratFromBig は 既約な x を Rational[T] に変換する. 表現できない場合は ErrOverflow を返す.
+func ratFromBig[T Ints](x *big.Rat) (Rational[T], error) {
+	num, ok1 := fromBigInt[T](x.Num())
+	den, ok2 := fromBigInt[T](x.Denom())
+	if !ok1 || !ok2 {
+		return Rational[T]{}, errors.ErrOverflow
+	}
+	return Rational[T]{num: num, dm1: den - 1}, nil
+}
+
+func toBigInt[T Ints](v T) *big.Int {
+	if v < 0 {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func fromBigInt[T Ints](x *big.Int) (T, bool) {
+	min, max := Limits[T]()
+	if x.Cmp(toBigInt(min)) < 0 || x.Cmp(toBigInt(max)) > 0 {
+		return 0, false
+	}
+	if x.Sign() < 0 {
+		return T(x.Int64()), true
+	}
+	return T(x.Uint64()), true
+}
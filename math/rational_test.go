@@ -0,0 +1,217 @@
+package math
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestNewRational(t *testing.T) {
+	testCases := []struct {
+		name string
+		num  int64
+		den  int64
+		exp  string
+		err  error
+	}{
+		{name: "Reduce", num: 6, den: 4, exp: "3/2"},
+		{name: "NegDen", num: 6, den: -4, exp: "-3/2"},
+		{name: "NegNeg", num: -6, den: -4, exp: "3/2"},
+		{name: "Integer", num: -8, den: 2, exp: "-4"},
+		{name: "Zero", num: 0, den: -5, exp: "0"},
+		{name: "MinReduce", num: -1 << 63, den: -2, exp: "4611686018427387904"},
+		{name: "MinOverflow", num: -1 << 63, den: -1, err: errors.ErrOverflow},
+		{name: "MinDen", num: 1, den: -1 << 63, err: errors.ErrOverflow},
+		{name: "ZeroDen", num: 1, den: 0, err: errors.ErrInvalidValue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := NewRational(tc.num, tc.den)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && out.String() != tc.exp {
+				t.Errorf("Expected %s, got %s instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	var zero Rational[int]
+	if zero.String() != "0" || zero.Den() != 1 || zero.Sign() != 0 {
+		t.Errorf("Expected zero value to be 0, got %s instead\n", zero)
+	}
+}
+
+// TestRationalArithmetic は int8 で表現可能な全ての有理数の組について 四則演算と比較を big.Rat による計算と比較する
+func TestRationalArithmetic(t *testing.T) {
+	values := []Rational[int8]{}
+	for _, num := range []int8{-128, -127, -64, -7, -3, -1, 0, 1, 2, 5, 12, 64, 127} {
+		for _, den := range []int8{1, 2, 3, 7, 16, 63, 64, 127, -128} {
+			if r, err := NewRational(num, den); err == nil {
+				values = append(values, r)
+			}
+		}
+	}
+	ops := []struct {
+		name string
+		op   func(a, b Rational[int8]) (Rational[int8], error)
+		big  func(z, a, b *big.Rat) *big.Rat
+	}{
+		{name: "Add", op: Rational[int8].Add, big: (*big.Rat).Add},
+		{name: "Sub", op: Rational[int8].Sub, big: (*big.Rat).Sub},
+		{name: "Mul", op: Rational[int8].Mul, big: (*big.Rat).Mul},
+		{name: "Div", op: Rational[int8].Div, big: (*big.Rat).Quo},
+	}
+	for _, a := range values {
+		for _, b := range values {
+			if out, exp := a.Compare(b), a.Rat().Cmp(b.Rat()); out != exp {
+				t.Fatalf("Compare(%s, %s): Expected %d, got %d instead\n", a, b, exp, out)
+			}
+			for _, op := range ops {
+				out, err := op.op(a, b)
+				if op.name == "Div" && b.Sign() == 0 {
+					if err != errors.ErrInvalidValue {
+						t.Fatalf("%s(%s, %s): Expected %v, got %v instead\n", op.name, a, b, errors.ErrInvalidValue, err)
+					}
+					continue
+				}
+				exp := op.big(new(big.Rat), a.Rat(), b.Rat())
+				if fits := exp.Num().IsInt64() && exp.Num().Int64() >= -128 && exp.Num().Int64() <= 127 && exp.Denom().Int64() <= 127; !fits {
+					if err != errors.ErrOverflow {
+						t.Fatalf("%s(%s, %s): Expected %v, got %s (%v) instead\n", op.name, a, b, errors.ErrOverflow, out, err)
+					}
+					continue
+				}
+				if err != nil || out.Rat().Cmp(exp) != 0 {
+					t.Fatalf("%s(%s, %s): Expected %s, got %s (%v) instead\n", op.name, a, b, exp.RatString(), out, err)
+				}
+			}
+		}
+	}
+}
+
+func TestRationalFloorCeil(t *testing.T) {
+	testCases := []struct {
+		name  string
+		num   int
+		den   int
+		floor int
+		ceil  int
+	}{
+		{name: "Pos", num: 7, den: 2, floor: 3, ceil: 4},
+		{name: "Neg", num: -7, den: 2, floor: -4, ceil: -3},
+		{name: "Integer", num: -6, den: 3, floor: -2, ceil: -2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, _ := NewRational(tc.num, tc.den)
+			if out := r.Floor(); out != tc.floor {
+				t.Errorf("Floor: Expected %d, got %d instead\n", tc.floor, out)
+			}
+			if out := r.Ceil(); out != tc.ceil {
+				t.Errorf("Ceil: Expected %d, got %d instead\n", tc.ceil, out)
+			}
+		})
+	}
+}
+
+func TestRationalZeroValue(t *testing.T) {
+	var zero Rational[int]
+	normalized, _ := NewRational(0, 5)
+	half, _ := NewRational(1, 2)
+	if zero != normalized {
+		t.Errorf("zero value should be equal to %v\n", normalized)
+	}
+	if zero == half {
+		t.Errorf("zero value should not be equal to %v\n", half)
+	}
+	if zero.Num() != 0 || zero.Den() != 1 || zero.Sign() != 0 || zero.Floor() != 0 || zero.Ceil() != 0 {
+		t.Errorf("Expected 0/1, got %d/%d instead\n", zero.Num(), zero.Den())
+	}
+	if zero.String() != "0" || zero.Float64() != 0 || zero.Rat().Sign() != 0 || zero.Compare(normalized) != 0 {
+		t.Errorf("Expected 0, got %v instead\n", zero)
+	}
+	for _, op := range []struct {
+		name string
+		f    func(a, b Rational[int]) (Rational[int], error)
+		exp  Rational[int]
+	}{
+		{name: "Add", f: Rational[int].Add, exp: half},
+		{name: "Sub", f: func(a, b Rational[int]) (Rational[int], error) { return b.Sub(a) }, exp: half},
+		{name: "Mul", f: Rational[int].Mul, exp: zero},
+		{name: "Div", f: Rational[int].Div, exp: zero},
+	} {
+		if out, err := op.f(zero, half); err != nil || out != op.exp {
+			t.Errorf("%s: Expected %v, got %v (%v) instead\n", op.name, op.exp, out, err)
+		}
+	}
+	if _, err := half.Div(zero); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+
+	// 等しい値は 構成方法によらず map の同じキーとなる
+	seen := map[Rational[int]]bool{zero: true}
+	for _, s := range []string{"0", "0/7", "-0/3"} {
+		r, err := ParseRational[int](s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !seen[r] {
+			t.Errorf("%q: Expected %v to be found in the map\n", s, r)
+		}
+	}
+	third, _ := NewRational(-2, -6)
+	if sum, _ := third.Add(third); sum != mustRational(t, 2, 3) {
+		t.Errorf("Expected 2/3, got %v instead\n", sum)
+	}
+}
+
+func mustRational(t *testing.T, num, den int) Rational[int] {
+	t.Helper()
+	r, err := NewRational(num, den)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestParseRational(t *testing.T) {
+	testCases := []struct {
+		name string
+		arg  string
+		exp  string
+		err  error
+	}{
+		{name: "Fraction", arg: "6/-4", exp: "-3/2"},
+		{name: "Integer", arg: "-12", exp: "-12"},
+		{name: "ReducedFits", arg: "18446744073709551616/4", exp: "4611686018427387904"},
+		{name: "Overflow", arg: "9223372036854775808", err: errors.ErrOverflow},
+		{name: "ZeroDen", arg: "1/0", err: errors.ErrInvalidValue},
+		{name: "Empty", arg: "", err: errors.ErrInvalidValue},
+		{name: "Malformed", arg: "1/2/3", err: errors.ErrInvalidValue},
+		{name: "Decimal", arg: "1.5", err: errors.ErrInvalidValue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ParseRational[int64](tc.arg)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && fmt.Sprint(out) != tc.exp {
+				t.Errorf("Expected %s, got %s instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	if _, err := ParseRational[uint8]("-1/2"); err != errors.ErrOverflow {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrOverflow, err)
+	}
+	if out, err := RationalFromRat[uint8](big.NewRat(510, 4)); err != nil || out.String() != "255/2" {
+		t.Errorf("Expected 255/2, got %s (%v) instead\n", out, err)
+	}
+}
@@ -0,0 +1,113 @@
+package math
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// BerlekampMassey は 素数を法とする列 s を生成する最小の線形漸化式
+// s[i] = Σ_{j=1}^{d} c[j-1] * s[i-j] (d <= i < len(s)) の係数 c と error 値 nil を返す.
+// 長さ 2d 以上の項が与えられていれば 次数 d の漸化式が一意に復元される.
+// 法が素数でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^2)
+func BerlekampMassey[M Modulus](s []ModInt[M]) ([]ModInt[M], error) {
+	if !isPrime(uint64(ModInt[M]{}.Mod())) {
+		return nil, errors.ErrInvalidValue
+	}
+	// cur は 現在の接続多項式 1 - Σ c[j] x^j, prev は 最後に次数が増えた時点の接続多項式である
+	cur, prev := []ModInt[M]{NewModInt[M](1)}, []ModInt[M]{NewModInt[M](1)}
+	l, shift, prevDelta := 0, 1, NewModInt[M](1)
+	for n := range s {
+		delta := s[n]
+		for i := 1; i <= l; i++ {
+			delta = delta.Add(cur[i].Mul(s[n-i]))
+		}
+		if delta.v == 0 {
+			shift++
+			continue
+		}
+		// 法が素数であるため 0 でない prevDelta は常に可逆である
+		inv, err := prevDelta.Inv()
+		if err != nil {
+			return nil, errors.ErrUnexpected
+		}
+		coef := delta.Mul(inv)
+		// cur - coef * x^shift * prev
+		size := len(cur)
+		if len(prev)+shift > size {
+			size = len(prev) + shift
+		}
+		next := make([]ModInt[M], size)
+		copy(next, cur)
+		for i := range prev {
+			next[i+shift] = next[i+shift].Sub(coef.Mul(prev[i]))
+		}
+		if 2*l <= n {
+			l, prev, prevDelta, shift = n+1-l, cur, delta, 1
+		} else {
+			shift++
+		}
+		cur = next
+	}
+	res := make([]ModInt[M], l)
+	for i := range res {
+		if i+1 < len(cur) {
+			res[i] = cur[i+1].Neg()
+		}
+	}
+	return res, nil
+}
+
+// KthTermOfLinearRecurrence は 初項 a[0], ..., a[d-1] と 線形漸化式 a[i] = Σ_{j=1}^{d} c[j-1] * a[i-j] で定まる列の
+// 第 k 項 (0-indexed) と error 値 nil を返す. d = len(c) である.
+// len(a) < len(c) の場合は ErrInvalidValue が error 値として返される. len(a) > len(c) の場合 超過した項は k < len(a) の場合にのみ参照される.
+// Bostan-Mori 法を用いる.
+// Time: O(d log d log k)
+func KthTermOfLinearRecurrence[M Modulus](a, c []ModInt[M], k uint64) (ModInt[M], error) {
+	d := len(c)
+	if len(a) < d {
+		return ModInt[M]{}, errors.ErrInvalidValue
+	}
+	if k < uint64(len(a)) {
+		return a[k], nil
+	}
+	if d == 0 {
+		return ModInt[M]{}, nil
+	}
+	// a の母関数は P(x) / Q(x) (Q(x) = 1 - Σ c[j-1] x^j, P(x) = A(x) Q(x) mod x^d) と表される
+	q := make([]ModInt[M], d+1)
+	q[0] = NewModInt[M](1)
+	for j := range c {
+		q[j+1] = c[j].Neg()
+	}
+	p, err := Convolution(a[:d], q)
+	if err != nil {
+		return ModInt[M]{}, err
+	}
+	p = p[:d]
+	// [x^k] P(x)/Q(x) = [x^(k/2)] (P(x)Q(-x))_{k mod 2} / (Q(x)Q(-x))_0 を繰り返し適用する
+	negQ := make([]ModInt[M], d+1)
+	for ; k > 0; k >>= 1 {
+		for i := range q {
+			negQ[i] = q[i]
+			if i%2 == 1 {
+				negQ[i] = q[i].Neg()
+			}
+		}
+		pq, err := Convolution(p, negQ)
+		if err != nil {
+			return ModInt[M]{}, err
+		}
+		qq, err := Convolution(q, negQ)
+		if err != nil {
+			return ModInt[M]{}, err
+		}
+		for i := range p {
+			p[i] = pq[2*i+int(k&1)]
+		}
+		for i := range q {
+			q[i] = qq[2*i]
+		}
+	}
+	// Q(0) = 1 が保たれる
+	return p[0], nil
+}
@@ -0,0 +1,178 @@
+package math
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func toMints(values ...int) []mint {
+	res := make([]mint, len(values))
+	for i, v := range values {
+		res[i] = NewModInt[Mod998244353](v)
+	}
+	return res
+}
+
+func TestBerlekampMassey(t *testing.T) {
+	testCases := []struct {
+		name string
+		seq  []int
+		exp  []int
+	}{
+		{name: "Empty", seq: []int{}, exp: []int{}},
+		{name: "Zeros", seq: []int{0, 0, 0, 0}, exp: []int{}},
+		{name: "Geometric", seq: []int{1, 3, 9, 27, 81}, exp: []int{3}},
+		{name: "Fibonacci", seq: []int{0, 1, 1, 2, 3, 5, 8, 13}, exp: []int{1, 1}},
+		{name: "Delayed", seq: []int{0, 0, 0, 1, 0, 0, 0, 0}, exp: []int{0, 0, 0, 0}},
+		{name: "Tribonacci", seq: []int{1, 1, 1, 3, 5, 9, 17, 31, 57}, exp: []int{1, 1, 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := BerlekampMassey(toMints(tc.seq...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := toMints(tc.exp...); !reflect.DeepEqual(out, exp) {
+				t.Errorf("Expected %v, got %v instead\n", exp, out)
+			}
+		})
+	}
+
+	// 法が素数でない場合は 列によらずエラーとなる
+	for _, seq := range [][]int{{}, {1, 2, 4, 8}, {2, 3, 0, 5}} {
+		s := make([]ModInt[Mod12], len(seq))
+		for i, v := range seq {
+			s[i] = NewModInt[Mod12](v)
+		}
+		if _, err := BerlekampMassey(s); err != errors.ErrInvalidValue {
+			t.Errorf("%v: Expected %v, got %v instead\n", seq, errors.ErrInvalidValue, err)
+		}
+	}
+
+	// ランダムな次数 d の漸化式から生成した 2d 項から 漸化式を復元する
+	rng := rand.New(rand.NewSource(1))
+	for d := 1; d <= 30; d++ {
+		c, s := make([]mint, d), make([]mint, 2*d)
+		for i := range c {
+			c[i] = NewModInt[Mod998244353](rng.Intn(998244353))
+		}
+		c[d-1] = NewModInt[Mod998244353](1 + rng.Intn(998244352))
+		for i := range s {
+			if i < d {
+				s[i] = NewModInt[Mod998244353](rng.Intn(998244353))
+				continue
+			}
+			for j := 1; j <= d; j++ {
+				s[i] = s[i].Add(c[j-1].Mul(s[i-j]))
+			}
+		}
+		out, err := BerlekampMassey(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 復元した漸化式が列全体を生成することを確かめる
+		for i := len(out); i < len(s); i++ {
+			var v mint
+			for j := 1; j <= len(out); j++ {
+				v = v.Add(out[j-1].Mul(s[i-j]))
+			}
+			if v != s[i] {
+				t.Fatalf("d = %d: recurrence %v does not generate s[%d]\n", d, out, i)
+			}
+		}
+		if len(out) > d {
+			t.Fatalf("d = %d: Expected length <= %d, got %d instead\n", d, d, len(out))
+		}
+	}
+}
+
+// kthTermByMatrix は 行列累乗により線形漸化式の第 k 項を求める
+func kthTermByMatrix(t *testing.T, a, c []mint, k uint64) mint {
+	t.Helper()
+	d := len(c)
+	f := ModField[Mod998244353]{}
+	m, err := NewMatrix[mint](f, d, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j := 0; j < d; j++ {
+		m.Set(0, j, c[j])
+	}
+	for i := 1; i < d; i++ {
+		m.Set(i, i-1, f.One())
+	}
+	if k < uint64(d) {
+		return a[k]
+	}
+	p, err := m.Pow(k - uint64(d) + 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 状態ベクトル (a[d-1], ..., a[0]) に作用させた先頭成分が a[k] である
+	var res mint
+	for j := 0; j < d; j++ {
+		v, _ := p.At(0, j)
+		res = res.Add(v.Mul(a[d-1-j]))
+	}
+	return res
+}
+
+func TestKthTermOfLinearRecurrence(t *testing.T) {
+	fib := toMints(0, 1)
+	testCases := []struct {
+		name string
+		k    uint64
+		exp  int
+	}{
+		{name: "Zero", k: 0, exp: 0},
+		{name: "One", k: 1, exp: 1},
+		{name: "Ten", k: 10, exp: 55},
+		{name: "Ninety", k: 90, exp: 2880067194370816120 % 998244353},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := KthTermOfLinearRecurrence(fib, toMints(1, 1), tc.k)
+			if err != nil || out.Val() != tc.exp {
+				t.Errorf("Expected %d, got %v (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 50; iter++ {
+		d := 1 + rng.Intn(12)
+		a, c := make([]mint, d), make([]mint, d)
+		for i := 0; i < d; i++ {
+			a[i] = NewModInt[Mod998244353](rng.Intn(998244353))
+			c[i] = NewModInt[Mod998244353](rng.Intn(998244353))
+		}
+		for _, k := range []uint64{0, uint64(d), uint64(rng.Intn(1000)), rng.Uint64() % 1e18} {
+			out, err := KthTermOfLinearRecurrence(a, c, k)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := kthTermByMatrix(t, a, c, k); out != exp {
+				t.Fatalf("d = %d, k = %d: Expected %v, got %v instead\n", d, k, exp, out)
+			}
+		}
+	}
+
+	// Berlekamp-Massey で復元した漸化式を用いて 遠い項を求める
+	seq := toMints(1, 2, 4, 8, 16, 32)
+	c, _ := BerlekampMassey(seq)
+	if out, err := KthTermOfLinearRecurrence(seq, c, 100); err != nil || out != NewModInt[Mod998244353](2).Pow(100) {
+		t.Errorf("Expected 2^100, got %v (%v) instead\n", out, err)
+	}
+
+	if out, err := KthTermOfLinearRecurrence(toMints(1, 2), nil, 5); err != nil || out.Val() != 0 {
+		t.Errorf("Expected 0, got %v (%v) instead\n", out, err)
+	}
+	if _, err := KthTermOfLinearRecurrence(toMints(1), toMints(1, 1), 5); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
@@ -0,0 +1,187 @@
+package math
+
+import (
+	stdmath "math"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Sieve は 線形篩により前計算した [0, N] の各整数の最小素因数を保持する.
+// 多数の整数の素因数分解や, 乗法的関数のテーブル構築に用いる.
+type Sieve struct {
+	spf    []int32 // spf[x] は x の最小素因数であり, x < 2 に対しては 0 である
+	primes []int
+}
+
+// NewSieve は [0, n] の範囲の整数を扱う Sieve を返す.
+// n は [0, 2^31) の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N)
+func NewSieve(n int) (*Sieve, error) {
+	if n < 0 || n >= 1<<31 {
+		return nil, errors.ErrInvalidValue
+	}
+	s := &Sieve{
+		spf:    make([]int32, n+1),
+		primes: []int{},
+	}
+	for i := 2; i <= n; i++ {
+		if s.spf[i] == 0 {
+			s.spf[i] = int32(i)
+			s.primes = append(s.primes, i)
+		}
+		for _, p := range s.primes {
+			if p > int(s.spf[i]) || i*p > n {
+				break
+			}
+			s.spf[i*p] = int32(p)
+		}
+	}
+	return s, nil
+}
+
+// Len は 扱う範囲の上限 N を返す
+// Time: O(1)
+func (s *Sieve) Len() int {
+	return len(s.spf) - 1
+}
+
+// Primes は N 以下の素数を昇順に並べたスライスを返す.
+// 返り値は Sieve の内部状態と共有されるため 変更してはならない.
+// Time: O(1)
+func (s *Sieve) Primes() []int {
+	return s.primes
+}
+
+// IsPrime は x が素数であるかを判定する.
+// x が [0, N] の範囲にない場合は false を返す.
+// Time: O(1)
+func (s *Sieve) IsPrime(x int) bool {
+	return 2 <= x && x < len(s.spf) && int(s.spf[x]) == x
+}
+
+// SmallestPrimeFactor は x の最小素因数と error 値 nil を返す.
+// x は [2, N] の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// error 値が nil でない場合の返り値は不定である.
+// Time: O(1)
+func (s *Sieve) SmallestPrimeFactor(x int) (int, error) {
+	if x < 2 || x >= len(s.spf) {
+		return 0, errors.ErrInvalidValue
+	}
+	return int(s.spf[x]), nil
+}
+
+// Factorize は x を素因数分解し, 素因数の昇順に並べた PrimeFactor のスライスと error 値 nil を返す.
+// x = 1 の場合は空のスライスを返す.
+// x は [1, N] の範囲になくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(log x)
+func (s *Sieve) Factorize(x int) ([]PrimeFactor[int], error) {
+	if x < 1 || x >= len(s.spf) {
+		return nil, errors.ErrInvalidValue
+	}
+	res := []PrimeFactor[int]{}
+	for x > 1 {
+		p := int(s.spf[x])
+		e := 0
+		for ; x%p == 0; x /= p {
+			e++
+		}
+		res = append(res, PrimeFactor[int]{Prime: p, Exp: e})
+	}
+	return res, nil
+}
+
+// Mobius は [0, N] の各整数 x に対する Möbius 関数 μ(x) の値を並べたスライスを返す.
+// μ(0) は 0 とする.
+// Time: O(N)
+func (s *Sieve) Mobius() []int {
+	res := make([]int, len(s.spf))
+	if len(res) > 1 {
+		res[1] = 1
+	}
+	for x := 2; x < len(res); x++ {
+		p := int(s.spf[x])
+		if y := x / p; y%p != 0 {
+			res[x] = -res[y]
+		}
+	}
+	return res
+}
+
+// Totient は [0, N] の各整数 x に対する Euler の φ 関数の値を並べたスライスを返す.
+// φ(0) は 0 とする.
+// Time: O(N)
+func (s *Sieve) Totient() []int {
+	res := make([]int, len(s.spf))
+	if len(res) > 1 {
+		res[1] = 1
+	}
+	for x := 2; x < len(res); x++ {
+		p := int(s.spf[x])
+		if y := x / p; y%p == 0 {
+			res[x] = res[y] * p
+		} else {
+			res[x] = res[y] * (p - 1)
+		}
+	}
+	return res
+}
+
+// SegmentedSieve は 区間 [l, r] に含まれる素数を昇順に並べたスライスと error 値 nil を返す.
+// 0 <= l <= r, r <= 10^12 かつ 区間長 r - l + 1 が 10^7 以下でなくてはならない.
+// 上記の条件が守られない場合は ErrInvalidValue が error 値として返される.
+// Time: O(√r log log r + (r-l) log log r)
+func SegmentedSieve(l, r int64) ([]int64, error) {
+	const maxR, maxWidth = 1e12, 1e7
+	if l < 0 || l > r || r > maxR || r-l+1 > maxWidth {
+		return nil, errors.ErrInvalidValue
+	}
+	if l < 2 {
+		l = 2
+	}
+	if l > r {
+		return []int64{}, nil
+	}
+	root := int(isqrt(uint64(r)))
+	small, err := NewSieve(root)
+	if err != nil {
+		return nil, err
+	}
+	composite := make([]bool, r-l+1)
+	for _, p := range small.Primes() {
+		p := int64(p)
+		start := (l + p - 1) / p * p
+		if start < p*p {
+			start = p * p
+		}
+		for x := start; x <= r; x += p {
+			composite[x-l] = true
+		}
+	}
+	res := []int64{}
+	for i, c := range composite {
+		if !c {
+			res = append(res, l+int64(i))
+		}
+	}
+	return res, nil
+}
+
+// isqrt は floor(√n) を返す
+// 浮動小数点数による近似値を整数演算で補正する
+func isqrt(n uint64) uint64 {
+	const limit = 1<<32 - 1 // floor(√(2^64 - 1))
+	x := uint64(stdmath.Sqrt(float64(n)))
+	if x > limit {
+		x = limit
+	}
+	for x*x > n {
+		x--
+	}
+	for x < limit && (x+1)*(x+1) <= n {
+		x++
+	}
+	return x
+}
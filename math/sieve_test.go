@@ -0,0 +1,155 @@
+package math
+
+import (
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestSieve(t *testing.T) {
+	const n = 2000
+	s, err := NewSieve(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Len() != n {
+		t.Errorf("Expected %d, got %d instead\n", n, s.Len())
+	}
+
+	primes := []int{}
+	for x := 0; x <= n; x++ {
+		if IsPrime(x) {
+			primes = append(primes, x)
+		}
+		if s.IsPrime(x) != IsPrime(x) {
+			t.Fatalf("IsPrime(%d): Expected %v, got %v instead\n", x, IsPrime(x), s.IsPrime(x))
+		}
+	}
+	if !reflect.DeepEqual(s.Primes(), primes) {
+		t.Errorf("Expected %v, got %v instead\n", primes, s.Primes())
+	}
+
+	mobius, totient := s.Mobius(), s.Totient()
+	for x := 1; x <= n; x++ {
+		exp, _ := Factorize(x)
+		out, err := s.Factorize(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(out, exp) {
+			t.Fatalf("Factorize(%d): Expected %v, got %v instead\n", x, exp, out)
+		}
+
+		expMobius := 1
+		for _, f := range exp {
+			if f.Exp > 1 {
+				expMobius = 0
+				break
+			}
+			expMobius = -expMobius
+		}
+		if mobius[x] != expMobius {
+			t.Fatalf("Mobius(%d): Expected %d, got %d instead\n", x, expMobius, mobius[x])
+		}
+
+		if expTotient, _ := Totient(x); totient[x] != expTotient {
+			t.Fatalf("Totient(%d): Expected %d, got %d instead\n", x, expTotient, totient[x])
+		}
+	}
+
+	for _, x := range []int{0, 1, -1, n + 1} {
+		if _, err := s.SmallestPrimeFactor(x); err != errors.ErrInvalidValue {
+			t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+		}
+	}
+	if _, err := s.Factorize(n + 1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := NewSieve(-1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestSegmentedSieve(t *testing.T) {
+	testCases := []struct {
+		name string
+		l    int64
+		r    int64
+		exp  []int64
+		err  error
+	}{
+		{
+			name: "Small",
+			l:    0,
+			r:    30,
+			exp:  []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+		{
+			name: "Single",
+			l:    97,
+			r:    97,
+			exp:  []int64{97},
+		},
+		{
+			name: "NoPrime",
+			l:    0,
+			r:    1,
+			exp:  []int64{},
+		},
+		{
+			name: "Large",
+			l:    1e12 - 100,
+			r:    1e12,
+			exp:  []int64{999999999937, 999999999959, 999999999961, 999999999989},
+		},
+		{
+			name: "Reversed",
+			l:    10,
+			r:    9,
+			err:  errors.ErrInvalidValue,
+		},
+		{
+			name: "TooLarge",
+			l:    1e12,
+			r:    1e12 + 1,
+			err:  errors.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := SegmentedSieve(tc.l, tc.r)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && !reflect.DeepEqual(out, tc.exp) {
+				t.Errorf("Expected %v, got %v instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	// Miller-Rabin との比較
+	const l, r = 1e9, 1e9 + 10000
+	out, err := SegmentedSieve(l, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []int64{}
+	for x := int64(l); x <= r; x++ {
+		if IsPrime(x) {
+			exp = append(exp, x)
+		}
+	}
+	if !reflect.DeepEqual(out, exp) {
+		t.Errorf("Expected %v, got %v instead\n", exp, out)
+	}
+}
+
+func BenchmarkSieve(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := NewSieve(1e7); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
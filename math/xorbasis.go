@@ -0,0 +1,241 @@
+package math
+
+import (
+	"math/bits"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// XorBasis は GF(2) 上のベクトルとしての uint64 の集合の 線形基底 (XOR 基底) を保持する.
+// 基底は常に簡約された階段形に保たれ, basis[b] は最上位 bit が b である基底ベクトル (存在しない場合は 0) である.
+// ゼロ値は空の集合を表す.
+type XorBasis struct {
+	basis [64]uint64
+	rank  int
+	size  int  // 挿入された要素数
+	zero  bool // 空でない部分集合の XOR として 0 が得られるか
+}
+
+// NewXorBasis は 空の XorBasis を初期化する
+// Time: O(1)
+func NewXorBasis() *XorBasis {
+	return &XorBasis{}
+}
+
+// Rank は 基底の大きさ (張る空間の次元) を返す
+// Time: O(1)
+func (xb *XorBasis) Rank() int {
+	return xb.rank
+}
+
+// Len は 挿入された要素数を返す
+// Time: O(1)
+func (xb *XorBasis) Len() int {
+	return xb.size
+}
+
+// Insert は x を集合に追加し, x が既存の要素と線形独立である (基底が大きくなった) かを返す
+// Time: O(64)
+func (xb *XorBasis) Insert(x uint64) bool {
+	xb.size++
+	x = xb.reduce(x)
+	if x == 0 {
+		xb.zero = true
+		return false
+	}
+	b := bits.Len64(x) - 1
+	// 他の基底ベクトルから bit b を取り除き 簡約された形を保つ
+	for i := b + 1; i < 64; i++ {
+		if xb.basis[i]>>b&1 == 1 {
+			xb.basis[i] ^= x
+		}
+	}
+	xb.basis[b] = x
+	xb.rank++
+	return true
+}
+
+// reduce は x を基底ベクトルで簡約した値を返す
+func (xb *XorBasis) reduce(x uint64) uint64 {
+	for b := 63; b >= 0; b-- {
+		if x>>b&1 == 1 && xb.basis[b] != 0 {
+			x ^= xb.basis[b]
+		}
+	}
+	return x
+}
+
+// Contains は x が 集合のいずれかの部分集合 (空集合を含む) の XOR として表せるかを返す
+// Time: O(64)
+func (xb *XorBasis) Contains(x uint64) bool {
+	return xb.reduce(x) == 0
+}
+
+// Max は 空でない部分集合の XOR の最大値と error 値 nil を返す.
+// 集合が空の場合は ErrNotFound が error 値として返される.
+// Time: O(64)
+func (xb *XorBasis) Max() (uint64, error) {
+	if xb.size == 0 {
+		return 0, errors.ErrNotFound
+	}
+	return xb.MaxXor(0), nil
+}
+
+// MaxXor は x と 部分集合 (空集合を含む) の XOR との XOR の最大値を返す
+// Time: O(64)
+func (xb *XorBasis) MaxXor(x uint64) uint64 {
+	for b := 63; b >= 0; b-- {
+		if x^xb.basis[b] > x {
+			x ^= xb.basis[b]
+		}
+	}
+	return x
+}
+
+// Min は 空でない部分集合の XOR の最小値と error 値 nil を返す.
+// 集合が空の場合は ErrNotFound が error 値として返される.
+// Time: O(64)
+func (xb *XorBasis) Min() (uint64, error) {
+	if xb.size == 0 {
+		return 0, errors.ErrNotFound
+	}
+	if xb.zero {
+		return 0, nil
+	}
+	// 簡約された基底では 最上位 bit が最小の基底ベクトルが最小値である
+	for b := 0; b < 64; b++ {
+		if xb.basis[b] != 0 {
+			return xb.basis[b], nil
+		}
+	}
+	return 0, errors.ErrUnexpected
+}
+
+// KthSmallest は 部分集合 (空集合を含む) の XOR として表せる相異なる値のうち k 番目 (0-indexed) に小さいものと error 値 nil を返す.
+// 表せる値は 2^Rank() 個であり, k = 0 の場合は 0 を返す.
+// k >= 2^Rank() の場合は ErrInvalidIndex が error 値として返される.
+// Time: O(64)
+func (xb *XorBasis) KthSmallest(k uint64) (uint64, error) {
+	if xb.rank < 64 && k>>xb.rank != 0 {
+		return 0, errors.ErrInvalidIndex
+	}
+	// 簡約された基底ベクトルを昇順に並べると, k の各 bit が 対応する基底ベクトルを用いるかを表す
+	var res uint64
+	for b := 0; b < 64 && k > 0; b++ {
+		if xb.basis[b] != 0 {
+			if k&1 == 1 {
+				res ^= xb.basis[b]
+			}
+			k >>= 1
+		}
+	}
+	return res, nil
+}
+
+// Basis は 基底ベクトルを降順に並べたスライスを返す
+// Time: O(64)
+func (xb *XorBasis) Basis() []uint64 {
+	res := make([]uint64, 0, xb.rank)
+	for b := 63; b >= 0; b-- {
+		if xb.basis[b] != 0 {
+			res = append(res, xb.basis[b])
+		}
+	}
+	return res
+}
+
+// Merge は other の全ての要素を xb に追加する. other は変更されない.
+// Time: O(64^2)
+func (xb *XorBasis) Merge(other *XorBasis) {
+	size := xb.size + other.size
+	xb.zero = xb.zero || other.zero
+	for b := 0; b < 64; b++ {
+		if other.basis[b] != 0 {
+			xb.Insert(other.basis[b])
+		}
+	}
+	xb.size = size
+}
+
+// PrefixXorBasis は 列の各接頭辞について 位置の大きい要素を優先した XOR 基底を保持し,
+// 任意の区間 [l, r) の要素の XOR に関する問い合わせに答える.
+type PrefixXorBasis struct {
+	basis [][64]uint64
+	pos   [][64]int32 // pos[r][b] は basis[r][b] を構成する要素の位置の最小値である
+}
+
+// NewPrefixXorBasis は 列 values に対する PrefixXorBasis を初期化する
+// Time: O(64 N)
+// Space: O(64 N)
+func NewPrefixXorBasis(values []uint64) *PrefixXorBasis {
+	pb := &PrefixXorBasis{
+		basis: make([][64]uint64, 1, len(values)+1),
+		pos:   make([][64]int32, 1, len(values)+1),
+	}
+	for _, x := range values {
+		pb.Append(x)
+	}
+	return pb
+}
+
+// Len は 列の長さを返す
+// Time: O(1)
+func (pb *PrefixXorBasis) Len() int {
+	return len(pb.basis) - 1
+}
+
+// Append は 列の末尾に x を追加する
+// Time: O(64)
+func (pb *PrefixXorBasis) Append(x uint64) {
+	n := len(pb.basis) - 1
+	basis, pos := pb.basis[n], pb.pos[n]
+	p := int32(n)
+	for b := 63; b >= 0 && x != 0; b-- {
+		if x>>b&1 == 0 {
+			continue
+		}
+		if basis[b] == 0 {
+			basis[b], pos[b] = x, p
+			break
+		}
+		// 位置の大きい方を基底に残し, 小さい方で簡約を続ける
+		if pos[b] < p {
+			basis[b], x = x, basis[b]
+			pos[b], p = p, pos[b]
+		}
+		x ^= basis[b]
+	}
+	pb.basis = append(pb.basis, basis)
+	pb.pos = append(pb.pos, pos)
+}
+
+// Max は 区間 [l, r) の要素からなる部分集合 (空集合を含む) の XOR の最大値と error 値 nil を返す.
+// 0 <= l <= r <= Len() でない場合は ErrInvalidIndex が error 値として返される.
+// Time: O(64)
+func (pb *PrefixXorBasis) Max(l, r int) (uint64, error) {
+	if l < 0 || l > r || r > pb.Len() {
+		return 0, errors.ErrInvalidIndex
+	}
+	var res uint64
+	for b := 63; b >= 0; b-- {
+		if int(pb.pos[r][b]) >= l && res^pb.basis[r][b] > res {
+			res ^= pb.basis[r][b]
+		}
+	}
+	return res, nil
+}
+
+// Contains は x が 区間 [l, r) の要素からなる部分集合 (空集合を含む) の XOR として表せるかと error 値 nil を返す.
+// 0 <= l <= r <= Len() でない場合は ErrInvalidIndex が error 値として返される.
+// Time: O(64)
+func (pb *PrefixXorBasis) Contains(l, r int, x uint64) (bool, error) {
+	if l < 0 || l > r || r > pb.Len() {
+		return false, errors.ErrInvalidIndex
+	}
+	for b := 63; b >= 0; b-- {
+		if x>>b&1 == 1 && pb.basis[r][b] != 0 && int(pb.pos[r][b]) >= l {
+			x ^= pb.basis[r][b]
+		}
+	}
+	return x == 0, nil
+}
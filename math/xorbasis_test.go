@@ -0,0 +1,166 @@
+package math
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// spanOf は values の部分集合 (空集合を含む) の XOR として表せる値を昇順に返す
+func spanOf(values []uint64) []uint64 {
+	set := map[uint64]bool{0: true}
+	for _, v := range values {
+		xs := make([]uint64, 0, len(set))
+		for x := range set {
+			xs = append(xs, x)
+		}
+		for _, x := range xs {
+			set[x^v] = true
+		}
+	}
+	res := make([]uint64, 0, len(set))
+	for x := range set {
+		res = append(res, x)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+// nonEmptyXors は values の空でない部分集合の XOR として表せる値の集合を返す
+func nonEmptyXors(values []uint64) map[uint64]bool {
+	set := map[uint64]bool{}
+	for _, v := range values {
+		next := map[uint64]bool{v: true}
+		for x := range set {
+			next[x] = true
+			next[x^v] = true
+		}
+		set = next
+	}
+	return set
+}
+
+func TestXorBasis(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		n, width := rng.Intn(10), 1+rng.Intn(8)
+		values := make([]uint64, n)
+		xb := NewXorBasis()
+		for i := range values {
+			values[i] = uint64(rng.Intn(1 << width))
+			independent := !xb.Contains(values[i])
+			if out := xb.Insert(values[i]); out != independent {
+				t.Fatalf("Insert(%d): Expected %v, got %v instead\n", values[i], independent, out)
+			}
+		}
+
+		span := spanOf(values)
+		if 1<<xb.Rank() != len(span) {
+			t.Fatalf("%v: Expected rank %d, got %d instead\n", values, len(span), xb.Rank())
+		}
+		for k, exp := range span {
+			if out, err := xb.KthSmallest(uint64(k)); err != nil || out != exp {
+				t.Fatalf("%v: KthSmallest(%d): Expected %d, got %d (%v) instead\n", values, k, exp, out, err)
+			}
+		}
+		if _, err := xb.KthSmallest(uint64(len(span))); err != errors.ErrInvalidIndex {
+			t.Fatalf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+		}
+		inSpan := map[uint64]bool{}
+		for _, x := range span {
+			inSpan[x] = true
+		}
+		for x := uint64(0); x < 1<<width; x++ {
+			if out := xb.Contains(x); out != inSpan[x] {
+				t.Fatalf("%v: Contains(%d): Expected %v, got %v instead\n", values, x, inSpan[x], out)
+			}
+		}
+
+		nonEmpty := nonEmptyXors(values)
+		max, errMax := xb.Max()
+		min, errMin := xb.Min()
+		if n == 0 {
+			if errMax != errors.ErrNotFound || errMin != errors.ErrNotFound {
+				t.Fatalf("Expected %v, got (%v, %v) instead\n", errors.ErrNotFound, errMax, errMin)
+			}
+			continue
+		}
+		expMax, expMin := uint64(0), ^uint64(0)
+		for x := range nonEmpty {
+			if x > expMax {
+				expMax = x
+			}
+			if x < expMin {
+				expMin = x
+			}
+		}
+		if errMax != nil || max != expMax {
+			t.Fatalf("%v: Max: Expected %d, got %d (%v) instead\n", values, expMax, max, errMax)
+		}
+		if errMin != nil || min != expMin {
+			t.Fatalf("%v: Min: Expected %d, got %d (%v) instead\n", values, expMin, min, errMin)
+		}
+	}
+}
+
+func TestXorBasisMerge(t *testing.T) {
+	a, b := NewXorBasis(), NewXorBasis()
+	for _, x := range []uint64{0b1100, 0b0110} {
+		a.Insert(x)
+	}
+	for _, x := range []uint64{0b1010, 0b0001} {
+		b.Insert(x)
+	}
+	a.Merge(b)
+	// 0b1100 ^ 0b0110 = 0b1010 より 0b1010 は従属である
+	if a.Rank() != 3 || a.Len() != 4 {
+		t.Errorf("Expected (rank, len) = (3, 4), got (%d, %d) instead\n", a.Rank(), a.Len())
+	}
+	if min, _ := a.Min(); min != 0 {
+		t.Errorf("Expected 0, got %d instead\n", min)
+	}
+	if max, _ := a.Max(); max != 0b1101 {
+		t.Errorf("Expected %d, got %d instead\n", 0b1101, max)
+	}
+	if b.Rank() != 2 || b.Len() != 2 {
+		t.Errorf("Expected other to be unchanged, got (%d, %d) instead\n", b.Rank(), b.Len())
+	}
+	if out := a.MaxXor(0b0010); out != 0b1111 {
+		t.Errorf("Expected %d, got %d instead\n", 0b1111, out)
+	}
+}
+
+func TestPrefixXorBasis(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	values := make([]uint64, 40)
+	for i := range values {
+		values[i] = uint64(rng.Intn(1 << 10))
+	}
+	pb := NewPrefixXorBasis(values)
+	if pb.Len() != len(values) {
+		t.Fatalf("Expected %d, got %d instead\n", len(values), pb.Len())
+	}
+	for l := 0; l <= len(values); l++ {
+		for r := l; r <= len(values); r++ {
+			xb := NewXorBasis()
+			for _, x := range values[l:r] {
+				xb.Insert(x)
+			}
+			if out, err := pb.Max(l, r); err != nil || out != xb.MaxXor(0) {
+				t.Fatalf("Max(%d, %d): Expected %d, got %d (%v) instead\n", l, r, xb.MaxXor(0), out, err)
+			}
+			x := uint64(rng.Intn(1 << 10))
+			if out, err := pb.Contains(l, r, x); err != nil || out != xb.Contains(x) {
+				t.Fatalf("Contains(%d, %d, %d): Expected %v, got %v (%v) instead\n", l, r, x, xb.Contains(x), out, err)
+			}
+		}
+	}
+
+	for _, lr := range [][2]int{{-1, 1}, {2, 1}, {0, 41}} {
+		if _, err := pb.Max(lr[0], lr[1]); err != errors.ErrInvalidIndex {
+			t.Errorf("Max(%d, %d): Expected %v, got %v instead\n", lr[0], lr[1], errors.ErrInvalidIndex, err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package swag
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+type folded[T any] struct {
+	value, fold T
+}
+
+// FoldableDeque は 両端からの追加・削除が可能であり, 含まれる要素を先頭から順に畳み込んだ値を取得できる両端キューである.
+type FoldableDeque[T any] struct {
+	// front の末尾が先頭の要素であり, fold は その要素から front の底までを畳み込んだ値である
+	// back の末尾が末尾の要素であり, fold は back の底からその要素までを畳み込んだ値である
+	front, back []folded[T]
+	op          MonoidFunc[T]
+	e           T
+}
+
+// NewDeque は モノイドの二項演算 MonoidFunc[T] と その単位元 e を引数にとり, 空の FoldableDeque[T] を返す.
+// Time: O(1)
+func NewDeque[T any](operator MonoidFunc[T], e T) *FoldableDeque[T] {
+	return &FoldableDeque[T]{
+		front: []folded[T]{},
+		back:  []folded[T]{},
+		op:    operator,
+		e:     e,
+	}
+}
+
+// Len は 呼び出し時点での要素数を返す
+// Time: O(1)
+func (d *FoldableDeque[T]) Len() int {
+	return len(d.front) + len(d.back)
+}
+
+// PushFront は 先頭に value 値を加える.
+// Time: O(1)
+func (d *FoldableDeque[T]) PushFront(value T) {
+	d.front = append(d.front, folded[T]{value: value, fold: d.op(value, d.frontFold())})
+}
+
+// PushBack は 末尾に value 値を加える.
+// Time: O(1)
+func (d *FoldableDeque[T]) PushBack(value T) {
+	d.back = append(d.back, folded[T]{value: value, fold: d.op(d.backFold(), value)})
+}
+
+// PopFront は 先頭の要素を取り除く.
+// 要素がない場合は ErrNotFound が error 値として返され,操作は棄却される.
+// Time: O(1) (amortized)
+func (d *FoldableDeque[T]) PopFront() error {
+	if d.Len() == 0 {
+		return errors.ErrNotFound
+	}
+	if len(d.front) == 0 {
+		d.rebalance(true)
+	}
+	d.front = d.front[:len(d.front)-1]
+	return nil
+}
+
+// PopBack は 末尾の要素を取り除く.
+// 要素がない場合は ErrNotFound が error 値として返され,操作は棄却される.
+// Time: O(1) (amortized)
+func (d *FoldableDeque[T]) PopBack() error {
+	if d.Len() == 0 {
+		return errors.ErrNotFound
+	}
+	if len(d.back) == 0 {
+		d.rebalance(false)
+	}
+	d.back = d.back[:len(d.back)-1]
+	return nil
+}
+
+// Fold は 含まれる要素を先頭から順に畳み込んだ値を返す.
+// 要素がない場合は単位元を返す.
+// Time: O(1)
+func (d *FoldableDeque[T]) Fold() T {
+	return d.op(d.frontFold(), d.backFold())
+}
+
+// Clear は 全要素を削除する
+// Time: O(1)
+func (d *FoldableDeque[T]) Clear() {
+	d.front, d.back = []folded[T]{}, []folded[T]{}
+}
+
+func (d *FoldableDeque[T]) frontFold() T {
+	if len(d.front) == 0 {
+		return d.e
+	}
+	return d.front[len(d.front)-1].fold
+}
+
+func (d *FoldableDeque[T]) backFold() T {
+	if len(d.back) == 0 {
+		return d.e
+	}
+	return d.back[len(d.back)-1].fold
+}
+
+// rebalance は 全要素を先頭から順に並べ直し, 空になった側のスタックへ半数を移す.
+// toFront が true の場合は front が空であり, 先頭側の半数 (切り上げ) を front へ移す.
+// toFront が false の場合は back が空であり, 末尾側の半数 (切り上げ) を back へ移す.
+func (d *FoldableDeque[T]) rebalance(toFront bool) {
+	values := make([]T, 0, d.Len())
+	for i := len(d.front) - 1; i >= 0; i-- {
+		values = append(values, d.front[i].value)
+	}
+	for i := range d.back {
+		values = append(values, d.back[i].value)
+	}
+	mid := len(values) / 2
+	if toFront {
+		mid = (len(values) + 1) / 2
+	}
+	d.front, d.back = d.front[:0], d.back[:0]
+	for i := mid - 1; i >= 0; i-- {
+		d.PushFront(values[i])
+	}
+	for i := mid; i < len(values); i++ {
+		d.PushBack(values[i])
+	}
+}
@@ -0,0 +1,91 @@
+package swag
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// MonoidFunc は モノイドの二項演算である.
+// 結合的でなくてはならないが, 可換である必要はない.
+type MonoidFunc[T any] func(left, right T) T
+
+// FoldableQueue は 2 本のスタックによるキューであり, 含まれる要素を先頭から順に畳み込んだ値を取得できる.
+// スライド区間での集約 (Sliding Window Aggregation) に用いる.
+type FoldableQueue[T any] struct {
+	// front は 先頭側の要素について, その要素から front の底までを畳み込んだ値を保持する
+	front    []T
+	back     []T
+	backFold T
+	op       MonoidFunc[T]
+	e        T
+}
+
+// New は モノイドの二項演算 MonoidFunc[T] と その単位元 e を引数にとり, 空の FoldableQueue[T] を返す.
+//
+// <ex>
+// [T = int, 区間の最大公約数]
+//
+//	func op(a, b int) int {
+//		return math.Gcd(a, b)
+//	}
+//	e := 0
+//
+// Time: O(1)
+func New[T any](operator MonoidFunc[T], e T) *FoldableQueue[T] {
+	return &FoldableQueue[T]{
+		front:    []T{},
+		back:     []T{},
+		backFold: e,
+		op:       operator,
+		e:        e,
+	}
+}
+
+// Len は 呼び出し時点での要素数を返す
+// Time: O(1)
+func (q *FoldableQueue[T]) Len() int {
+	return len(q.front) + len(q.back)
+}
+
+// Push は 末尾に value 値を加える.
+// Time: O(1)
+func (q *FoldableQueue[T]) Push(value T) {
+	q.back = append(q.back, value)
+	q.backFold = q.op(q.backFold, value)
+}
+
+// Pop は 先頭の要素を取り除く.
+// 要素がない場合は ErrNotFound が error 値として返され,操作は棄却される.
+// Time: O(1) (amortized)
+func (q *FoldableQueue[T]) Pop() error {
+	if len(q.front) == 0 {
+		if len(q.back) == 0 {
+			return errors.ErrNotFound
+		}
+		fold := q.e
+		for i := len(q.back) - 1; i >= 0; i-- {
+			fold = q.op(q.back[i], fold)
+			q.front = append(q.front, fold)
+		}
+		q.back = q.back[:0]
+		q.backFold = q.e
+	}
+	q.front = q.front[:len(q.front)-1]
+	return nil
+}
+
+// Fold は 含まれる要素を先頭から順に畳み込んだ値を返す.
+// 要素がない場合は単位元を返す.
+// Time: O(1)
+func (q *FoldableQueue[T]) Fold() T {
+	if len(q.front) == 0 {
+		return q.backFold
+	}
+	return q.op(q.front[len(q.front)-1], q.backFold)
+}
+
+// Clear は 全要素を削除する
+// Time: O(1)
+func (q *FoldableQueue[T]) Clear() {
+	q.front, q.back = []T{}, []T{}
+	q.backFold = q.e
+}
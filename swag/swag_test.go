@@ -0,0 +1,133 @@
+package swag_test
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+	swag "github.com/hiden2000/go_ds/swag"
+)
+
+const mod = 998244353
+
+// affine は x -> a*x + b を表す
+type affine struct {
+	a, b int
+}
+
+// compose は left を適用した後に right を適用する写像を返す
+func compose(left, right affine) affine {
+	return affine{a: left.a * right.a % mod, b: (left.b*right.a + right.b) % mod}
+}
+
+func foldAffine(fs []affine) affine {
+	res := affine{a: 1}
+	for _, f := range fs {
+		res = compose(res, f)
+	}
+	return res
+}
+
+func TestFoldableQueue(t *testing.T) {
+	const q = 3000
+	rng := rand.New(rand.NewSource(1))
+	queue := swag.New(compose, affine{a: 1})
+	naive := []affine{}
+
+	for i := 0; i < q; i++ {
+		if rng.Intn(3) == 0 {
+			err := queue.Pop()
+			if len(naive) == 0 {
+				if err != errors.ErrNotFound {
+					t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			naive = naive[1:]
+		} else {
+			f := affine{a: rng.Intn(mod), b: rng.Intn(mod)}
+			queue.Push(f)
+			naive = append(naive, f)
+		}
+		if queue.Len() != len(naive) {
+			t.Fatalf("Expected %d, got %d instead.", len(naive), queue.Len())
+		}
+		if exp, get := foldAffine(naive), queue.Fold(); exp != get {
+			t.Fatalf("Expected %v, got %v instead.", exp, get)
+		}
+	}
+}
+
+func TestSlidingGcd(t *testing.T) {
+	args := []int{12, 18, 24, 7, 14, 21, 28}
+	exp := []int{6, 1, 1, 7, 7}
+
+	queue := swag.New(math.Gcd[int], 0)
+	for i, v := range args {
+		queue.Push(v)
+		if i < 2 {
+			continue
+		}
+		if get := queue.Fold(); get != exp[i-2] {
+			t.Errorf("Expected %d, got %d instead.", exp[i-2], get)
+		}
+		if err := queue.Pop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFoldableDeque(t *testing.T) {
+	const q = 3000
+	rng := rand.New(rand.NewSource(2))
+	concat := func(left, right string) string { return left + right }
+	dq := swag.NewDeque(concat, "")
+	naive := []string{}
+
+	for i := 0; i < q; i++ {
+		switch rng.Intn(4) {
+		case 0:
+			dq.PushFront(strconv.Itoa(i))
+			naive = append([]string{strconv.Itoa(i)}, naive...)
+		case 1:
+			dq.PushBack(strconv.Itoa(i))
+			naive = append(naive, strconv.Itoa(i))
+		case 2:
+			err := dq.PopFront()
+			if len(naive) == 0 {
+				if err != errors.ErrNotFound {
+					t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			naive = naive[1:]
+		case 3:
+			err := dq.PopBack()
+			if len(naive) == 0 {
+				if err != errors.ErrNotFound {
+					t.Fatalf("Expected %v, got %v instead.", errors.ErrNotFound, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			naive = naive[:len(naive)-1]
+		}
+		if dq.Len() != len(naive) {
+			t.Fatalf("Expected %d, got %d instead.", len(naive), dq.Len())
+		}
+		if exp, get := strings.Join(naive, ""), dq.Fold(); exp != get {
+			t.Fatalf("Expected %s, got %s instead.", exp, get)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package utils
+
+import (
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+)
+
+// Number は 累積和や差分配列が扱うことのできる値の型である.
+type Number interface {
+	math.Ints | math.Floats
+}
+
+// PrefixSum は 1 次元の列の累積和を保持し, 区間和に答える.
+type PrefixSum[T Number] struct {
+	sums []T // sums[i] は values[0] + ... + values[i-1] である
+}
+
+// NewPrefixSum は 列 values の PrefixSum を初期化する
+// Time: O(N)
+func NewPrefixSum[T Number](values []T) *PrefixSum[T] {
+	sums := make([]T, len(values)+1)
+	for i, v := range values {
+		sums[i+1] = sums[i] + v
+	}
+	return &PrefixSum[T]{sums: sums}
+}
+
+// Len は 列の長さを返す
+// Time: O(1)
+func (p *PrefixSum[T]) Len() int {
+	return len(p.sums) - 1
+}
+
+// Sum は 区間 [l, r) の和と error 値 nil を返す.
+// 0 <= l <= r <= Len() でない場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (p *PrefixSum[T]) Sum(l, r int) (T, error) {
+	if l < 0 || l > r || r > p.Len() {
+		return 0, errors.ErrInvalidIndex
+	}
+	return p.sums[r] - p.sums[l], nil
+}
+
+// PrefixSum2D は 2 次元の格子の累積和を保持し, 長方形領域の和に答える.
+type PrefixSum2D[T Number] struct {
+	sums [][]T // sums[i][j] は [0, i) × [0, j) の和である
+}
+
+// NewPrefixSum2D は 格子 grid の PrefixSum2D と error 値 nil を返す.
+// grid の各行の長さが等しくない場合は ErrInvalidValue が error 値として返される.
+// Time: O(HW)
+func NewPrefixSum2D[T Number](grid [][]T) (*PrefixSum2D[T], error) {
+	h, w := len(grid), 0
+	if h > 0 {
+		w = len(grid[0])
+	}
+	sums := make([][]T, h+1)
+	sums[0] = make([]T, w+1)
+	for i, row := range grid {
+		if len(row) != w {
+			return nil, errors.ErrInvalidValue
+		}
+		sums[i+1] = make([]T, w+1)
+		for j, v := range row {
+			sums[i+1][j+1] = sums[i+1][j] + sums[i][j+1] - sums[i][j] + v
+		}
+	}
+	return &PrefixSum2D[T]{sums: sums}, nil
+}
+
+// Rows は 格子の行数を返す
+// Time: O(1)
+func (p *PrefixSum2D[T]) Rows() int {
+	return len(p.sums) - 1
+}
+
+// Cols は 格子の列数を返す
+// Time: O(1)
+func (p *PrefixSum2D[T]) Cols() int {
+	return len(p.sums[0]) - 1
+}
+
+// Sum は 長方形領域 [r1, r2) × [c1, c2) の和と error 値 nil を返す.
+// 0 <= r1 <= r2 <= Rows() かつ 0 <= c1 <= c2 <= Cols() でない場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (p *PrefixSum2D[T]) Sum(r1, c1, r2, c2 int) (T, error) {
+	if r1 < 0 || r1 > r2 || r2 > p.Rows() || c1 < 0 || c1 > c2 || c2 > p.Cols() {
+		return 0, errors.ErrInvalidIndex
+	}
+	return p.sums[r2][c2] - p.sums[r1][c2] - p.sums[r2][c1] + p.sums[r1][c1], nil
+}
+
+// Imos は 差分配列 (いもす法) により 区間への加算をまとめて行う.
+// 全ての加算の後に Build を呼び出して 各要素の値を得る.
+type Imos[T Number] struct {
+	diff []T
+}
+
+// NewImos は 長さ n の 全ての要素が 0 である列に対する Imos と error 値 nil を返す.
+// n < 0 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(N)
+func NewImos[T Number](n int) (*Imos[T], error) {
+	if n < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	return &Imos[T]{diff: make([]T, n+1)}, nil
+}
+
+// Len は 列の長さを返す
+// Time: O(1)
+func (im *Imos[T]) Len() int {
+	return len(im.diff) - 1
+}
+
+// Add は 区間 [l, r) の各要素に v を加算し, error 値 nil を返す.
+// 0 <= l <= r <= Len() でない場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (im *Imos[T]) Add(l, r int, v T) error {
+	if l < 0 || l > r || r > im.Len() {
+		return errors.ErrInvalidIndex
+	}
+	im.diff[l] += v
+	im.diff[r] -= v
+	return nil
+}
+
+// Build は これまでの加算を反映した列を返す
+// Time: O(N)
+func (im *Imos[T]) Build() []T {
+	res := make([]T, im.Len())
+	var acc T
+	for i := range res {
+		acc += im.diff[i]
+		res[i] = acc
+	}
+	return res
+}
+
+// Imos2D は 2 次元の差分配列 (いもす法) により 長方形領域への加算をまとめて行う.
+// 全ての加算の後に Build を呼び出して 各要素の値を得る.
+type Imos2D[T Number] struct {
+	diff [][]T
+}
+
+// NewImos2D は h × w の 全ての要素が 0 である格子に対する Imos2D と error 値 nil を返す.
+// h < 0 あるいは w < 0 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(HW)
+func NewImos2D[T Number](h, w int) (*Imos2D[T], error) {
+	if h < 0 || w < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	diff := make([][]T, h+1)
+	for i := range diff {
+		diff[i] = make([]T, w+1)
+	}
+	return &Imos2D[T]{diff: diff}, nil
+}
+
+// Rows は 格子の行数を返す
+// Time: O(1)
+func (im *Imos2D[T]) Rows() int {
+	return len(im.diff) - 1
+}
+
+// Cols は 格子の列数を返す
+// Time: O(1)
+func (im *Imos2D[T]) Cols() int {
+	return len(im.diff[0]) - 1
+}
+
+// Add は 長方形領域 [r1, r2) × [c1, c2) の各要素に v を加算し, error 値 nil を返す.
+// 0 <= r1 <= r2 <= Rows() かつ 0 <= c1 <= c2 <= Cols() でない場合は ErrInvalidIndex が error 値として返される.
+// Time: O(1)
+func (im *Imos2D[T]) Add(r1, c1, r2, c2 int, v T) error {
+	if r1 < 0 || r1 > r2 || r2 > im.Rows() || c1 < 0 || c1 > c2 || c2 > im.Cols() {
+		return errors.ErrInvalidIndex
+	}
+	im.diff[r1][c1] += v
+	im.diff[r1][c2] -= v
+	im.diff[r2][c1] -= v
+	im.diff[r2][c2] += v
+	return nil
+}
+
+// Build は これまでの加算を反映した格子を返す
+// Time: O(HW)
+func (im *Imos2D[T]) Build() [][]T {
+	h, w := im.Rows(), im.Cols()
+	res := make([][]T, h)
+	for i := range res {
+		res[i] = make([]T, w)
+		for j := range res[i] {
+			res[i][j] = im.diff[i][j]
+			if i > 0 {
+				res[i][j] += res[i-1][j]
+			}
+			if j > 0 {
+				res[i][j] += res[i][j-1]
+			}
+			if i > 0 && j > 0 {
+				res[i][j] -= res[i-1][j-1]
+			}
+		}
+	}
+	return res
+}
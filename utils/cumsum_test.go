@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestPrefixSum(t *testing.T) {
+	values := []int{3, -1, 4, 1, -5, 9}
+	p := NewPrefixSum(values)
+	for l := 0; l <= len(values); l++ {
+		for r := l; r <= len(values); r++ {
+			exp := 0
+			for _, v := range values[l:r] {
+				exp += v
+			}
+			if out, err := p.Sum(l, r); err != nil || out != exp {
+				t.Fatalf("Sum(%d, %d): Expected %d, got %d (%v) instead\n", l, r, exp, out, err)
+			}
+		}
+	}
+	for _, lr := range [][2]int{{-1, 2}, {3, 2}, {0, 7}} {
+		if _, err := p.Sum(lr[0], lr[1]); err != errors.ErrInvalidIndex {
+			t.Errorf("Sum(%d, %d): Expected %v, got %v instead\n", lr[0], lr[1], errors.ErrInvalidIndex, err)
+		}
+	}
+
+	f := NewPrefixSum([]float64{0.5, 0.25, 0.125})
+	if out, _ := f.Sum(0, 3); out != 0.875 {
+		t.Errorf("Expected 0.875, got %v instead\n", out)
+	}
+}
+
+func TestPrefixSum2D(t *testing.T) {
+	grid := [][]int{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	}
+	p, err := NewPrefixSum2D(grid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for r1 := 0; r1 <= 3; r1++ {
+		for r2 := r1; r2 <= 3; r2++ {
+			for c1 := 0; c1 <= 4; c1++ {
+				for c2 := c1; c2 <= 4; c2++ {
+					exp := 0
+					for i := r1; i < r2; i++ {
+						for j := c1; j < c2; j++ {
+							exp += grid[i][j]
+						}
+					}
+					if out, err := p.Sum(r1, c1, r2, c2); err != nil || out != exp {
+						t.Fatalf("Sum(%d, %d, %d, %d): Expected %d, got %d (%v) instead\n", r1, c1, r2, c2, exp, out, err)
+					}
+				}
+			}
+		}
+	}
+	if _, err := p.Sum(0, 0, 4, 1); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+	}
+	if _, err := NewPrefixSum2D([][]int{{1, 2}, {3}}); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if empty, err := NewPrefixSum2D([][]int{}); err != nil || empty.Rows() != 0 || empty.Cols() != 0 {
+		t.Errorf("Expected empty grid, got %v instead\n", err)
+	}
+}
+
+func TestImos(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const n = 20
+	im, err := NewImos[int64](n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := make([]int64, n)
+	for i := 0; i < 100; i++ {
+		l := rng.Intn(n + 1)
+		r := l + rng.Intn(n+1-l)
+		v := int64(rng.Intn(21) - 10)
+		if err := im.Add(l, r, v); err != nil {
+			t.Fatal(err)
+		}
+		for j := l; j < r; j++ {
+			exp[j] += v
+		}
+	}
+	if out := im.Build(); !reflect.DeepEqual(out, exp) {
+		t.Errorf("Expected %v, got %v instead\n", exp, out)
+	}
+	if err := im.Add(3, 21, 1); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+	}
+	if _, err := NewImos[int](-1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestImos2D(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const h, w = 6, 7
+	im, err := NewImos2D[int](h, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := make([][]int, h)
+	for i := range exp {
+		exp[i] = make([]int, w)
+	}
+	for k := 0; k < 50; k++ {
+		r1, c1 := rng.Intn(h+1), rng.Intn(w+1)
+		r2, c2 := r1+rng.Intn(h+1-r1), c1+rng.Intn(w+1-c1)
+		v := rng.Intn(21) - 10
+		if err := im.Add(r1, c1, r2, c2, v); err != nil {
+			t.Fatal(err)
+		}
+		for i := r1; i < r2; i++ {
+			for j := c1; j < c2; j++ {
+				exp[i][j] += v
+			}
+		}
+	}
+	if out := im.Build(); !reflect.DeepEqual(out, exp) {
+		t.Errorf("Expected %v, got %v instead\n", exp, out)
+	}
+	if err := im.Add(0, 0, 7, 1, 1); err != errors.ErrInvalidIndex {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidIndex, err)
+	}
+	if _, err := NewImos2D[float64](2, -1); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
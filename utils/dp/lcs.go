@@ -0,0 +1,103 @@
+package dp
+
+// LCS は a と b の最長共通部分列の 1 つを返す
+// Time: O(NM)
+// Space: O(NM)
+func LCS[T comparable](a, b []T) []T {
+	n, m := len(a), len(b)
+	// dp[i][j] は a[i:] と b[j:] の最長共通部分列の長さである
+	dp := make([][]int, n+1)
+	for i := range dp {
+		dp[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				dp[i][j] = dp[i+1][j+1] + 1
+			case dp[i+1][j] >= dp[i][j+1]:
+				dp[i][j] = dp[i+1][j]
+			default:
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+	res := make([]T, 0, dp[0][0])
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case a[i] == b[j]:
+			res = append(res, a[i])
+			i, j = i+1, j+1
+		case dp[i+1][j] >= dp[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return res
+}
+
+// EditKind は 編集操作の種類である.
+type EditKind int
+
+const (
+	// Match は a[AIndex] と b[BIndex] が等しく そのまま残す操作である
+	Match EditKind = iota
+	// Substitute は a[AIndex] を b[BIndex] に置き換える操作である
+	Substitute
+	// Insert は b[BIndex] を挿入する操作である. AIndex は挿入位置 (a における直後の要素の添字) である
+	Insert
+	// Delete は a[AIndex] を削除する操作である. BIndex は削除位置 (b における直後の要素の添字) である
+	Delete
+)
+
+// EditOp は a を b に変換する編集操作の 1 つである.
+type EditOp struct {
+	Kind   EditKind
+	AIndex int
+	BIndex int
+}
+
+// EditDistance は a を b に変換するのに必要な 挿入・削除・置換の最小回数 (Levenshtein 距離) と,
+// それを達成する編集操作の列 (Match を含み, a と b の先頭から順に並ぶ) を返す.
+// Time: O(NM)
+// Space: O(NM)
+func EditDistance[T comparable](a, b []T) (int, []EditOp) {
+	n, m := len(a), len(b)
+	// dp[i][j] は a[i:] を b[j:] に変換する最小回数である
+	dp := make([][]int, n+1)
+	for i := range dp {
+		dp[i] = make([]int, m+1)
+		dp[i][m] = n - i
+	}
+	for j := 0; j <= m; j++ {
+		dp[n][j] = m - j
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1]
+				continue
+			}
+			dp[i][j] = 1 + min(dp[i+1][j+1], dp[i+1][j], dp[i][j+1])
+		}
+	}
+	ops := []EditOp{}
+	for i, j := 0, 0; i < n || j < m; {
+		switch {
+		case i < n && j < m && a[i] == b[j] && dp[i][j] == dp[i+1][j+1]:
+			ops = append(ops, EditOp{Kind: Match, AIndex: i, BIndex: j})
+			i, j = i+1, j+1
+		case i < n && j < m && dp[i][j] == dp[i+1][j+1]+1:
+			ops = append(ops, EditOp{Kind: Substitute, AIndex: i, BIndex: j})
+			i, j = i+1, j+1
+		case i < n && dp[i][j] == dp[i+1][j]+1:
+			ops = append(ops, EditOp{Kind: Delete, AIndex: i, BIndex: j})
+			i++
+		default:
+			ops = append(ops, EditOp{Kind: Insert, AIndex: i, BIndex: j})
+			j++
+		}
+	}
+	return dp[0][0], ops
+}
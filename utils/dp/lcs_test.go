@@ -0,0 +1,117 @@
+package dp_test
+
+import (
+	"math/rand"
+	"testing"
+
+	dp "github.com/hiden2000/go_ds/utils/dp"
+)
+
+// isSubsequence は s が t の部分列であるかを判定する
+func isSubsequence(s, t []byte) bool {
+	i := 0
+	for j := 0; i < len(s) && j < len(t); j++ {
+		if s[i] == t[j] {
+			i++
+		}
+	}
+	return i == len(s)
+}
+
+func TestLCS(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		exp  int
+	}{
+		{name: "empty", a: "", b: "abc", exp: 0},
+		{name: "disjoint", a: "abc", b: "xyz", exp: 0},
+		{name: "classic", a: "ABCBDAB", b: "BDCABA", exp: 4},
+		{name: "same", a: "hello", b: "hello", exp: 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := dp.LCS([]byte(tc.a), []byte(tc.b))
+			if len(out) != tc.exp || !isSubsequence(out, []byte(tc.a)) || !isSubsequence(out, []byte(tc.b)) {
+				t.Errorf("Expected common subsequence of length %d, got %q instead\n", tc.exp, out)
+			}
+		})
+	}
+}
+
+// applyEdits は 編集操作の列を a に適用した結果を返す
+func applyEdits(t *testing.T, a, b []byte, ops []dp.EditOp) ([]byte, int) {
+	t.Helper()
+	res, cost, i, j := []byte{}, 0, 0, 0
+	for _, op := range ops {
+		if op.AIndex != i || op.BIndex != j {
+			t.Fatalf("unexpected operation %+v at (%d, %d)\n", op, i, j)
+		}
+		switch op.Kind {
+		case dp.Match:
+			if a[i] != b[j] {
+				t.Fatalf("Match on different values %q, %q\n", a[i], b[j])
+			}
+			res = append(res, a[i])
+			i, j = i+1, j+1
+		case dp.Substitute:
+			res = append(res, b[j])
+			i, j, cost = i+1, j+1, cost+1
+		case dp.Insert:
+			res = append(res, b[j])
+			j, cost = j+1, cost+1
+		case dp.Delete:
+			i, cost = i+1, cost+1
+		}
+	}
+	return res, cost
+}
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		exp  int
+	}{
+		{name: "empty", a: "", b: "", exp: 0},
+		{name: "insert only", a: "", b: "abc", exp: 3},
+		{name: "delete only", a: "abc", b: "", exp: 3},
+		{name: "kitten", a: "kitten", b: "sitting", exp: 3},
+		{name: "flaw", a: "flaw", b: "lawn", exp: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dist, ops := dp.EditDistance([]byte(tc.a), []byte(tc.b))
+			if dist != tc.exp {
+				t.Fatalf("Expected %d, got %d instead\n", tc.exp, dist)
+			}
+			out, cost := applyEdits(t, []byte(tc.a), []byte(tc.b), ops)
+			if string(out) != tc.b || cost != dist {
+				t.Errorf("Expected %q with cost %d, got %q with cost %d instead\n", tc.b, dist, out, cost)
+			}
+		})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		a, b := make([]byte, rng.Intn(8)), make([]byte, rng.Intn(8))
+		for i := range a {
+			a[i] = byte('a' + rng.Intn(3))
+		}
+		for i := range b {
+			b[i] = byte('a' + rng.Intn(3))
+		}
+		dist, ops := dp.EditDistance(a, b)
+		if out, cost := applyEdits(t, a, b, ops); string(out) != string(b) || cost != dist {
+			t.Fatalf("%q -> %q: got %q with cost %d (distance %d)\n", a, b, out, cost, dist)
+		}
+		// 距離は LCS による上界 len(a) + len(b) - 2 LCS 以下である
+		if lcs := len(dp.LCS(a, b)); dist > len(a)+len(b)-2*lcs {
+			t.Fatalf("%q -> %q: distance %d exceeds LCS bound\n", a, b, dist)
+		}
+	}
+}
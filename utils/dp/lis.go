@@ -0,0 +1,41 @@
+package dp
+
+import (
+	"cmp"
+	"sort"
+)
+
+// LIS は values の最長増加部分列の 1 つを 添字の昇順のスライスとして返す.
+// strict が true の場合は狭義単調増加 (a < b), false の場合は広義単調増加 (a <= b) の部分列を求める.
+// Time: O(N log N)
+func LIS[T cmp.Ordered](values []T, strict bool) []int {
+	// tails[k] は 長さ k+1 の増加部分列の末尾として取り得る最小の値を持つ要素の添字である
+	tails := []int{}
+	prev := make([]int, len(values))
+	for i, v := range values {
+		k := sort.Search(len(tails), func(j int) bool {
+			if strict {
+				return !cmp.Less(values[tails[j]], v)
+			}
+			return cmp.Less(v, values[tails[j]])
+		})
+		if k > 0 {
+			prev[i] = tails[k-1]
+		} else {
+			prev[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	res := make([]int, len(tails))
+	if len(tails) == 0 {
+		return res
+	}
+	for k, i := len(tails)-1, tails[len(tails)-1]; k >= 0; k, i = k-1, prev[i] {
+		res[k] = i
+	}
+	return res
+}
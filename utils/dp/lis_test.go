@@ -0,0 +1,78 @@
+package dp_test
+
+import (
+	"math/rand"
+	"testing"
+
+	dp "github.com/hiden2000/go_ds/utils/dp"
+)
+
+// naiveLIS は 全ての部分列を調べて 最長増加部分列の長さを返す
+func naiveLIS(values []int, strict bool) int {
+	best := 0
+	for mask := 0; mask < 1<<len(values); mask++ {
+		prev, length, ok := 0, 0, true
+		for i, v := range values {
+			if mask>>i&1 == 0 {
+				continue
+			}
+			if length > 0 && (v < prev || strict && v == prev) {
+				ok = false
+				break
+			}
+			prev, length = v, length+1
+		}
+		if ok && length > best {
+			best = length
+		}
+	}
+	return best
+}
+
+func TestLIS(t *testing.T) {
+	testCases := []struct {
+		name   string
+		args   []int
+		strict bool
+		exp    []int
+	}{
+		{name: "empty", args: []int{}, strict: true, exp: []int{}},
+		{name: "strict", args: []int{3, 1, 4, 1, 5, 9, 2, 6}, strict: true, exp: []int{1, 2, 4, 7}},
+		{name: "non-strict", args: []int{2, 2, 1, 2, 3}, strict: false, exp: []int{0, 1, 3, 4}},
+		{name: "equal strict", args: []int{2, 2, 2}, strict: true, exp: []int{2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := dp.LIS(tc.args, tc.strict)
+			if len(out) != len(tc.exp) {
+				t.Fatalf("Expected %v, got %v instead\n", tc.exp, out)
+			}
+			for i := range out {
+				if out[i] != tc.exp[i] {
+					t.Fatalf("Expected %v, got %v instead\n", tc.exp, out)
+				}
+			}
+		})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 300; iter++ {
+		values := make([]int, rng.Intn(12))
+		for i := range values {
+			values[i] = rng.Intn(5)
+		}
+		for _, strict := range []bool{true, false} {
+			out := dp.LIS(values, strict)
+			if exp := naiveLIS(values, strict); len(out) != exp {
+				t.Fatalf("%v (strict = %v): Expected length %d, got %v instead\n", values, strict, exp, out)
+			}
+			for k := 1; k < len(out); k++ {
+				a, b := values[out[k-1]], values[out[k]]
+				if out[k-1] >= out[k] || a > b || strict && a == b {
+					t.Fatalf("%v (strict = %v): %v is not increasing\n", values, strict, out)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package dp
+
+import (
+	"cmp"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	utils "github.com/hiden2000/go_ds/utils"
+)
+
+// MaxSubarray は Kadane のアルゴリズムにより values の空でない連続部分列の和の最大値 sum と,
+// それを達成する区間 [l, r) (複数ある場合は そのうちの 1 つ) および error 値 nil を返す.
+// values が空の場合は ErrNotFound が error 値として返される.
+// Time: O(N)
+func MaxSubarray[T utils.Number](values []T) (sum T, l, r int, err error) {
+	if len(values) == 0 {
+		return sum, 0, 0, errors.ErrNotFound
+	}
+	// cur は values[start:i+1] の和であり, i で終わる連続部分列の和の最大値である
+	cur, start := values[0], 0
+	sum, l, r = cur, 0, 1
+	for i := 1; i < len(values); i++ {
+		if cur <= 0 {
+			cur, start = values[i], i
+		} else {
+			cur += values[i]
+		}
+		if cur > sum {
+			sum, l, r = cur, start, i+1
+		}
+	}
+	return sum, l, r, nil
+}
+
+// CountInversions は i < j かつ values[i] > values[j] を満たす組 (i, j) の個数を返す.
+// 座標圧縮した値を Fenwick 木に記録しながら数える.
+// Time: O(N log N)
+func CountInversions[T cmp.Ordered](values []T) int64 {
+	sorted, indices := utils.Compress(values)
+	// tree は 1-indexed の Fenwick 木であり, 既に見た値の出現回数を保持する
+	tree := make([]int64, len(sorted)+1)
+	var res int64
+	for seen, x := range indices {
+		// 既に見た値のうち x 以下のものの個数
+		var le int64
+		for i := x + 1; i > 0; i -= i & -i {
+			le += tree[i]
+		}
+		res += int64(seen) - le
+		for i := x + 1; i < len(tree); i += i & -i {
+			tree[i]++
+		}
+	}
+	return res
+}
@@ -0,0 +1,104 @@
+package dp_test
+
+import (
+	"math/rand"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	dp "github.com/hiden2000/go_ds/utils/dp"
+)
+
+func TestMaxSubarray(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []int
+		sum  int
+		l    int
+		r    int
+	}{
+		{name: "single", args: []int{5}, sum: 5, l: 0, r: 1},
+		{name: "classic", args: []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}, sum: 6, l: 3, r: 7},
+		{name: "all negative", args: []int{-3, -1, -2}, sum: -1, l: 1, r: 2},
+		{name: "all positive", args: []int{1, 2, 3}, sum: 6, l: 0, r: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sum, l, r, err := dp.MaxSubarray(tc.args)
+			if err != nil || sum != tc.sum || l != tc.l || r != tc.r {
+				t.Errorf("Expected (%d, %d, %d), got (%d, %d, %d) (%v) instead\n", tc.sum, tc.l, tc.r, sum, l, r, err)
+			}
+		})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 300; iter++ {
+		values := make([]float64, 1+rng.Intn(10))
+		for i := range values {
+			values[i] = float64(rng.Intn(11) - 5)
+		}
+		best := values[0]
+		for i := range values {
+			s := 0.0
+			for j := i; j < len(values); j++ {
+				s += values[j]
+				if s > best {
+					best = s
+				}
+			}
+		}
+		sum, l, r, err := dp.MaxSubarray(values)
+		check := 0.0
+		for _, v := range values[l:r] {
+			check += v
+		}
+		if err != nil || sum != best || check != sum || l >= r {
+			t.Fatalf("%v: Expected %v, got %v on [%d, %d) (%v) instead\n", values, best, sum, l, r, err)
+		}
+	}
+
+	if _, _, _, err := dp.MaxSubarray([]int{}); err != errors.ErrNotFound {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrNotFound, err)
+	}
+}
+
+func TestCountInversions(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []int
+		exp  int64
+	}{
+		{name: "empty", args: []int{}, exp: 0},
+		{name: "sorted", args: []int{1, 2, 3}, exp: 0},
+		{name: "reversed", args: []int{5, 4, 3, 2, 1}, exp: 10},
+		{name: "duplicates", args: []int{2, 2, 1, 2}, exp: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if out := dp.CountInversions(tc.args); out != tc.exp {
+				t.Errorf("Expected %d, got %d instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	values := make([]int, 300)
+	for i := range values {
+		values[i] = rng.Intn(50) - 25
+	}
+	var exp int64
+	for i := range values {
+		for j := i + 1; j < len(values); j++ {
+			if values[i] > values[j] {
+				exp++
+			}
+		}
+	}
+	if out := dp.CountInversions(values); out != exp {
+		t.Errorf("Expected %d, got %d instead\n", exp, out)
+	}
+	if out := dp.CountInversions([]string{"c", "a", "b"}); out != 2 {
+		t.Errorf("Expected 2, got %d instead\n", out)
+	}
+}
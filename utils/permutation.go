@@ -0,0 +1,264 @@
+package utils
+
+import (
+	"cmp"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// NextPermutation は s を 辞書順で次の順列に並べ替え, true を返す.
+// s が辞書順で最後の順列である場合は s を最初の順列 (昇順) に並べ替え, false を返す.
+// 重複する要素を含む場合は 相異なる順列のみを列挙する.
+// Time: O(N)
+func NextPermutation[T cmp.Ordered](s []T) bool {
+	return nextPermutation(s, cmp.Less[T])
+}
+
+// PrevPermutation は s を 辞書順で前の順列に並べ替え, true を返す.
+// s が辞書順で最初の順列である場合は s を最後の順列 (降順) に並べ替え, false を返す.
+// 重複する要素を含む場合は 相異なる順列のみを列挙する.
+// Time: O(N)
+func PrevPermutation[T cmp.Ordered](s []T) bool {
+	return nextPermutation(s, func(left, right T) bool { return cmp.Less(right, left) })
+}
+
+func nextPermutation[T any](s []T, less func(left, right T) bool) bool {
+	// s[i] < s[i+1] を満たす最大の i を探す
+	i := len(s) - 2
+	for i >= 0 && !less(s[i], s[i+1]) {
+		i--
+	}
+	if i < 0 {
+		Reverse(s)
+		return false
+	}
+	// s[i] < s[j] を満たす最大の j と交換し, 以降を昇順にする
+	j := len(s) - 1
+	for !less(s[i], s[j]) {
+		j--
+	}
+	s[i], s[j] = s[j], s[i]
+	Reverse(s[i+1:])
+	return true
+}
+
+// Combinations は {0, 1, ..., n-1} から k 個を選ぶ組み合わせを 辞書順に列挙するイテレータである.
+//
+//	c, _ := NewCombinations(n, k)
+//	for c.Next() {
+//		indices := c.Indices()
+//	}
+type Combinations struct {
+	n, k    int
+	indices []int
+	started bool
+	done    bool
+}
+
+// NewCombinations は {0, 1, ..., n-1} から k 個を選ぶ組み合わせのイテレータと error 値 nil を返す.
+// 0 <= k <= n でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(K)
+func NewCombinations(n, k int) (*Combinations, error) {
+	if k < 0 || k > n {
+		return nil, errors.ErrInvalidValue
+	}
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+	return &Combinations{n: n, k: k, indices: indices}, nil
+}
+
+// Next は 次の組み合わせに進み, 組み合わせが存在するかを返す. 最初の呼び出しでは 最初の組み合わせに進む.
+// Time: O(K)
+func (c *Combinations) Next() bool {
+	if c.done {
+		return false
+	}
+	if !c.started {
+		c.started = true
+		return true
+	}
+	// n-k+i 未満である最大の i を進め, 以降を詰める
+	i := c.k - 1
+	for i >= 0 && c.indices[i] == c.n-c.k+i {
+		i--
+	}
+	if i < 0 {
+		c.done = true
+		return false
+	}
+	c.indices[i]++
+	for j := i + 1; j < c.k; j++ {
+		c.indices[j] = c.indices[j-1] + 1
+	}
+	return true
+}
+
+// Indices は 現在の組み合わせを 昇順の添字のスライスとして返す.
+// 返り値は次の Next の呼び出しで上書きされる.
+// Time: O(1)
+func (c *Combinations) Indices() []int {
+	return c.indices
+}
+
+// Submasks は mask の部分集合 (mask 自身と 0 を含む) を 降順に列挙するイテレータである.
+//
+//	it := NewSubmasks(mask)
+//	for it.Next() {
+//		sub := it.Mask()
+//	}
+type Submasks struct {
+	mask, cur uint64
+	started   bool
+	done      bool
+}
+
+// NewSubmasks は mask の部分集合を列挙するイテレータを返す
+// Time: O(1)
+func NewSubmasks(mask uint64) *Submasks {
+	return &Submasks{mask: mask, cur: mask}
+}
+
+// Next は 次の部分集合に進み, 部分集合が存在するかを返す. 最初の呼び出しでは mask 自身に進む.
+// 全ての mask について部分集合を列挙する総計算量は O(3^N) である.
+// Time: O(1)
+func (s *Submasks) Next() bool {
+	if s.done {
+		return false
+	}
+	if !s.started {
+		s.started = true
+		return true
+	}
+	if s.cur == 0 {
+		s.done = true
+		return false
+	}
+	s.cur = (s.cur - 1) & s.mask
+	return true
+}
+
+// Mask は 現在の部分集合を返す
+// Time: O(1)
+func (s *Submasks) Mask() uint64 {
+	return s.cur
+}
+
+// Subset は mask の立っている bit に対応する s の要素を 添字の昇順に並べたスライスを返す.
+// len(s) 以上の位置の bit は無視される.
+// Time: O(N)
+func Subset[T any](s []T, mask uint64) []T {
+	res := []T{}
+	for i := 0; i < len(s) && i < 64; i++ {
+		if mask>>i&1 == 1 {
+			res = append(res, s[i])
+		}
+	}
+	return res
+}
+
+// maxRankLen は 順位を int で表せる順列の最大の長さである (20! < 2^63)
+const maxRankLen = 20
+
+// PermutationRank は {0, 1, ..., n-1} の順列 p の 辞書順での順位 (0-indexed) と error 値 nil を返す.
+// p が順列でない場合 あるいは n > 20 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^2)
+func PermutationRank(p []int) (int, error) {
+	if len(p) > maxRankLen || !isPermutation(p) {
+		return 0, errors.ErrInvalidValue
+	}
+	n, rank, fact := len(p), 0, 1
+	// 後ろから見て p[i] より小さい後続の要素数を数える
+	for i := n - 1; i >= 0; i-- {
+		smaller := 0
+		for _, v := range p[i+1:] {
+			if v < p[i] {
+				smaller++
+			}
+		}
+		rank += smaller * fact
+		fact *= n - i
+	}
+	return rank, nil
+}
+
+// PermutationUnrank は 辞書順で rank 番目 (0-indexed) の {0, 1, ..., n-1} の順列と error 値 nil を返す.
+// 0 <= n <= 20 かつ 0 <= rank < n! でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N^2)
+func PermutationUnrank(n, rank int) ([]int, error) {
+	if n < 0 || n > maxRankLen || rank < 0 {
+		return nil, errors.ErrInvalidValue
+	}
+	fact := 1
+	for i := 2; i <= n; i++ {
+		fact *= i
+	}
+	if rank >= fact {
+		return nil, errors.ErrInvalidValue
+	}
+	rest := make([]int, n)
+	for i := range rest {
+		rest[i] = i
+	}
+	p := make([]int, 0, n)
+	for i := n; i > 0; i-- {
+		fact /= i
+		j := rank / fact
+		rank %= fact
+		p = append(p, rest[j])
+		rest = append(rest[:j], rest[j+1:]...)
+	}
+	return p, nil
+}
+
+// InversePermutation は {0, 1, ..., n-1} の順列 p の逆置換 q (q[p[i]] = i) と error 値 nil を返す.
+// p が順列でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N)
+func InversePermutation(p []int) ([]int, error) {
+	if !isPermutation(p) {
+		return nil, errors.ErrInvalidValue
+	}
+	q := make([]int, len(p))
+	for i, v := range p {
+		q[v] = i
+	}
+	return q, nil
+}
+
+// Cycles は {0, 1, ..., n-1} の順列 p の巡回置換への分解と error 値 nil を返す.
+// 各巡回は最小の要素から始まり i, p[i], p[p[i]], ... の順に並び, 巡回どうしは先頭の要素の昇順に並ぶ.
+// 不動点は長さ 1 の巡回として含まれる.
+// p が順列でない場合は ErrInvalidValue が error 値として返される.
+// Time: O(N)
+func Cycles(p []int) ([][]int, error) {
+	if !isPermutation(p) {
+		return nil, errors.ErrInvalidValue
+	}
+	visited := make([]bool, len(p))
+	cycles := [][]int{}
+	for i := range p {
+		if visited[i] {
+			continue
+		}
+		cycle := []int{}
+		for j := i; !visited[j]; j = p[j] {
+			visited[j] = true
+			cycle = append(cycle, j)
+		}
+		cycles = append(cycles, cycle)
+	}
+	return cycles, nil
+}
+
+// isPermutation は p が {0, 1, ..., n-1} の順列であるかを判定する
+func isPermutation(p []int) bool {
+	seen := make([]bool, len(p))
+	for _, v := range p {
+		if v < 0 || v >= len(p) || seen[v] {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
@@ -0,0 +1,192 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestNextPermutation(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []int
+		exp  [][]int
+	}{
+		{
+			name: "distinct",
+			args: []int{1, 2, 3},
+			exp:  [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}},
+		},
+		{
+			name: "multiset",
+			args: []int{1, 1, 2},
+			exp:  [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}},
+		},
+		{
+			name: "empty",
+			args: []int{},
+			exp:  [][]int{{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := append([]int{}, tc.args...)
+			out := [][]int{}
+			for ok := true; ok; ok = NextPermutation(s) {
+				out = append(out, append([]int{}, s...))
+			}
+			if !reflect.DeepEqual(out, tc.exp) {
+				t.Errorf("Expected %v, got %v instead\n", tc.exp, out)
+			}
+			// 最後の順列の後は最初の順列に戻る
+			if !reflect.DeepEqual(s, tc.args) {
+				t.Errorf("Expected %v, got %v instead\n", tc.args, s)
+			}
+
+			// PrevPermutation は逆順に列挙する
+			s = append([]int{}, tc.exp[len(tc.exp)-1]...)
+			out = [][]int{}
+			for ok := true; ok; ok = PrevPermutation(s) {
+				out = append([][]int{append([]int{}, s...)}, out...)
+			}
+			if !reflect.DeepEqual(out, tc.exp) {
+				t.Errorf("Expected %v, got %v instead\n", tc.exp, out)
+			}
+		})
+	}
+
+	s := []string{"c", "b", "a"}
+	if NextPermutation(s) || !reflect.DeepEqual(s, []string{"a", "b", "c"}) {
+		t.Errorf("Expected false and [a b c], got %v instead\n", s)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	c, err := NewCombinations(5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := [][]int{}
+	for c.Next() {
+		out = append(out, append([]int{}, c.Indices()...))
+	}
+	exp := [][]int{
+		{0, 1, 2}, {0, 1, 3}, {0, 1, 4}, {0, 2, 3}, {0, 2, 4},
+		{0, 3, 4}, {1, 2, 3}, {1, 2, 4}, {1, 3, 4}, {2, 3, 4},
+	}
+	if !reflect.DeepEqual(out, exp) {
+		t.Errorf("Expected %v, got %v instead\n", exp, out)
+	}
+	if c.Next() {
+		t.Errorf("Expected exhausted iterator\n")
+	}
+
+	for _, nk := range [][3]int{{4, 0, 1}, {4, 4, 1}, {0, 0, 1}} {
+		c, _ := NewCombinations(nk[0], nk[1])
+		count := 0
+		for c.Next() {
+			count++
+		}
+		if count != nk[2] {
+			t.Errorf("C(%d, %d): Expected %d, got %d instead\n", nk[0], nk[1], nk[2], count)
+		}
+	}
+	for _, nk := range [][2]int{{3, 4}, {3, -1}, {-1, 0}} {
+		if _, err := NewCombinations(nk[0], nk[1]); err != errors.ErrInvalidValue {
+			t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+		}
+	}
+}
+
+func TestSubmasks(t *testing.T) {
+	it := NewSubmasks(0b1011)
+	out := []uint64{}
+	for it.Next() {
+		out = append(out, it.Mask())
+	}
+	exp := []uint64{0b1011, 0b1010, 0b1001, 0b1000, 0b0011, 0b0010, 0b0001, 0}
+	if !reflect.DeepEqual(out, exp) {
+		t.Errorf("Expected %v, got %v instead\n", exp, out)
+	}
+
+	it = NewSubmasks(0)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 1 {
+		t.Errorf("Expected 1, got %d instead\n", count)
+	}
+
+	// 全ての mask の部分集合の総数は 3^N である
+	total := 0
+	for mask := uint64(0); mask < 1<<6; mask++ {
+		for it := NewSubmasks(mask); it.Next(); {
+			total++
+		}
+	}
+	if total != 729 {
+		t.Errorf("Expected 729, got %d instead\n", total)
+	}
+
+	if out := Subset([]string{"a", "b", "c", "d"}, 0b1101); !reflect.DeepEqual(out, []string{"a", "c", "d"}) {
+		t.Errorf("Expected [a c d], got %v instead\n", out)
+	}
+}
+
+func TestPermutationRank(t *testing.T) {
+	p := []int{0, 1, 2, 3, 4}
+	for rank := 0; ; rank++ {
+		out, err := PermutationRank(p)
+		if err != nil || out != rank {
+			t.Fatalf("%v: Expected %d, got %d (%v) instead\n", p, rank, out, err)
+		}
+		q, err := PermutationUnrank(len(p), rank)
+		if err != nil || !reflect.DeepEqual(q, p) {
+			t.Fatalf("Unrank(%d): Expected %v, got %v (%v) instead\n", rank, p, q, err)
+		}
+		if !NextPermutation(p) {
+			break
+		}
+	}
+
+	big := make([]int, 20)
+	for i := range big {
+		big[i] = 19 - i
+	}
+	if out, err := PermutationRank(big); err != nil || out != 2432902008176639999 {
+		t.Errorf("Expected 20!-1, got %d (%v) instead\n", out, err)
+	}
+	if _, err := PermutationRank([]int{0, 0, 1}); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := PermutationUnrank(3, 6); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := PermutationUnrank(21, 0); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestInverseCycles(t *testing.T) {
+	p := []int{2, 0, 1, 3, 5, 4}
+	q, err := InversePermutation(p)
+	if exp := []int{1, 2, 0, 3, 5, 4}; err != nil || !reflect.DeepEqual(q, exp) {
+		t.Errorf("Expected %v, got %v (%v) instead\n", exp, q, err)
+	}
+	cycles, err := Cycles(p)
+	if exp := [][]int{{0, 2, 1}, {3}, {4, 5}}; err != nil || !reflect.DeepEqual(cycles, exp) {
+		t.Errorf("Expected %v, got %v (%v) instead\n", exp, cycles, err)
+	}
+
+	for _, p := range [][]int{{1, 1}, {0, 2}, {-1, 0}} {
+		if _, err := InversePermutation(p); err != errors.ErrInvalidValue {
+			t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+		}
+		if _, err := Cycles(p); err != errors.ErrInvalidValue {
+			t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+		}
+	}
+}
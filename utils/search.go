@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"cmp"
+	stdmath "math"
+
+	errors "github.com/hiden2000/go_ds/errors"
+	math "github.com/hiden2000/go_ds/math"
+	set "github.com/hiden2000/go_ds/set"
+)
+
+// BinarySearchInt は 区間 [lo, hi) 上で単調な (false, ..., false, true, ..., true の形の) 述語 pred に対し,
+// pred(x) が true となる最小の x と error 値 nil を返す. そのような x が存在しない場合は hi を返す.
+// pred は区間 [lo, hi) の値に対してのみ呼び出される.
+// lo > hi の場合は ErrInvalidValue が error 値として返される.
+// Time: O(log(hi - lo)) 回の pred の呼び出し
+func BinarySearchInt[T math.Ints](lo, hi T, pred func(T) bool) (T, error) {
+	if lo > hi {
+		return lo, errors.ErrInvalidValue
+	}
+	for lo < hi {
+		// オーバーフローしない 切り捨ての平均値
+		mid := (lo & hi) + (lo^hi)>>1
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
+
+// BinarySearchFloat は 区間 [lo, hi] 上で単調な (false から true に切り替わる) 述語 pred に対し,
+// 二分探索を iter 回行って得た 切り替わる点の近似値 (pred が true となる側の端点) と error 値 nil を返す.
+// 誤差は (hi - lo) / 2^iter 程度である.
+// lo > hi あるいは iter < 0 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(iter) 回の pred の呼び出し
+func BinarySearchFloat[T math.Floats](lo, hi T, iter int, pred func(T) bool) (T, error) {
+	if !(lo <= hi) || iter < 0 {
+		return lo, errors.ErrInvalidValue
+	}
+	for i := 0; i < iter; i++ {
+		mid := lo + (hi-lo)/2
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
+// LowerBound は 順序 op (op(a, b) は a < b を表す) に関してソート済みの列 s において
+// op(s[i], value) が false となる最小の添字 i (value 以上の最初の要素の位置) を返す. 存在しない場合は len(s) を返す.
+// Time: O(log N)
+func LowerBound[T comparable](s []T, value T, op set.OrderableFunc[T]) int {
+	i, _ := BinarySearchInt(0, len(s), func(i int) bool { return !op(s[i], value) })
+	return i
+}
+
+// UpperBound は 順序 op (op(a, b) は a < b を表す) に関してソート済みの列 s において
+// op(value, s[i]) が true となる最小の添字 i (value より大きい最初の要素の位置) を返す. 存在しない場合は len(s) を返す.
+// Time: O(log N)
+func UpperBound[T comparable](s []T, value T, op set.OrderableFunc[T]) int {
+	i, _ := BinarySearchInt(0, len(s), func(i int) bool { return op(value, s[i]) })
+	return i
+}
+
+// TernarySearchInt は 区間 [lo, hi) 上で 狭義単調減少した後に広義単調増加する関数 f に対し,
+// f(x) を最小にする x のうち最小のものと error 値 nil を返す. 最大値を求める場合は 符号を反転した関数を用いる.
+// lo >= hi の場合は ErrInvalidValue が error 値として返される.
+// Time: O(log(hi - lo)) 回の f の呼び出し
+func TernarySearchInt[T math.Ints, U cmp.Ordered](lo, hi T, f func(T) U) (T, error) {
+	if lo >= hi {
+		return lo, errors.ErrInvalidValue
+	}
+	// f(x) <= f(x+1) となる最初の x が最小値をとる点である. 隣接差の符号による二分探索は 三分探索と同じ役割を果たす
+	return BinarySearchInt(lo, hi-1, func(x T) bool { return !cmp.Less(f(x+1), f(x)) })
+}
+
+// invPhi は 黄金比の逆数 (√5 - 1) / 2 である
+var invPhi = (stdmath.Sqrt(5) - 1) / 2
+
+// GoldenSectionSearch は 区間 [lo, hi] 上で単峰な (最小値をとる点の左で狭義単調減少し 右で狭義単調増加する) 関数 f に対し,
+// 黄金分割探索を iter 回行って得た 最小値をとる点の近似値と error 値 nil を返す.
+// 1 回の反復ごとに区間の幅は約 0.618 倍となり, f の呼び出しは 1 回で済む.
+// lo > hi あるいは iter < 0 の場合は ErrInvalidValue が error 値として返される.
+// Time: O(iter) 回の f の呼び出し
+func GoldenSectionSearch[T math.Floats](lo, hi T, iter int, f func(T) T) (T, error) {
+	if !(lo <= hi) || iter < 0 {
+		return lo, errors.ErrInvalidValue
+	}
+	c := T(invPhi)
+	x1, x2 := hi-c*(hi-lo), lo+c*(hi-lo)
+	f1, f2 := f(x1), f(x2)
+	for i := 0; i < iter; i++ {
+		if f1 < f2 {
+			hi, x2, f2 = x2, x1, f1
+			x1 = hi - c*(hi-lo)
+			f1 = f(x1)
+		} else {
+			lo, x1, f1 = x1, x2, f2
+			x2 = lo + c*(hi-lo)
+			f2 = f(x2)
+		}
+	}
+	return lo + (hi-lo)/2, nil
+}
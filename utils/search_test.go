@@ -0,0 +1,144 @@
+package utils
+
+import (
+	stdmath "math"
+	"sort"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestBinarySearchInt(t *testing.T) {
+	testCases := []struct {
+		name string
+		lo   int64
+		hi   int64
+		pred func(int64) bool
+		exp  int64
+	}{
+		{name: "middle", lo: 0, hi: 100, pred: func(x int64) bool { return x*x >= 50 }, exp: 8},
+		{name: "first", lo: -10, hi: 10, pred: func(x int64) bool { return true }, exp: -10},
+		{name: "none", lo: -10, hi: 10, pred: func(x int64) bool { return false }, exp: 10},
+		{name: "empty", lo: 5, hi: 5, pred: func(x int64) bool { panic("must not be called") }, exp: 5},
+		{name: "negative", lo: -1000, hi: 0, pred: func(x int64) bool { return x >= -333 }, exp: -333},
+		{name: "wide", lo: stdmath.MinInt64, hi: stdmath.MaxInt64, pred: func(x int64) bool { return x >= 1<<62 }, exp: 1 << 62},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := BinarySearchInt(tc.lo, tc.hi, tc.pred)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %d, got %d (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+
+	// int8 の全範囲で境界を変えて確かめる
+	for b := -128; b <= 127; b++ {
+		out, err := BinarySearchInt(int8(-128), int8(127), func(x int8) bool { return int(x) >= b })
+		if err != nil || int(out) != b {
+			t.Fatalf("Expected %d, got %d (%v) instead\n", b, out, err)
+		}
+	}
+	if out, err := BinarySearchInt(uint8(0), uint8(255), func(x uint8) bool { return x >= 200 }); err != nil || out != 200 {
+		t.Errorf("Expected 200, got %d (%v) instead\n", out, err)
+	}
+	if _, err := BinarySearchInt(3, 2, func(int) bool { return true }); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestBinarySearchFloat(t *testing.T) {
+	out, err := BinarySearchFloat(0.0, 2.0, 100, func(x float64) bool { return x*x >= 2 })
+	if err != nil || stdmath.Abs(out-stdmath.Sqrt2) > 1e-12 {
+		t.Errorf("Expected %v, got %v (%v) instead\n", stdmath.Sqrt2, out, err)
+	}
+	if _, err := BinarySearchFloat(1.0, 0.0, 10, func(float64) bool { return true }); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+	if _, err := BinarySearchFloat(float32(0), 1, -1, func(float32) bool { return true }); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	less := func(left, right int) bool { return left < right }
+	s := []int{1, 3, 3, 3, 5, 8}
+	for v := 0; v <= 9; v++ {
+		if out, exp := LowerBound(s, v, less), sort.SearchInts(s, v); out != exp {
+			t.Errorf("LowerBound(%d): Expected %d, got %d instead\n", v, exp, out)
+		}
+		if out, exp := UpperBound(s, v, less), sort.SearchInts(s, v+1); out != exp {
+			t.Errorf("UpperBound(%d): Expected %d, got %d instead\n", v, exp, out)
+		}
+	}
+
+	// 降順の列
+	greater := func(left, right string) bool { return left > right }
+	desc := []string{"z", "m", "m", "a"}
+	if out := LowerBound(desc, "m", greater); out != 1 {
+		t.Errorf("Expected 1, got %d instead\n", out)
+	}
+	if out := UpperBound(desc, "m", greater); out != 3 {
+		t.Errorf("Expected 3, got %d instead\n", out)
+	}
+	if out := LowerBound([]int{}, 1, less); out != 0 {
+		t.Errorf("Expected 0, got %d instead\n", out)
+	}
+}
+
+func TestTernarySearchInt(t *testing.T) {
+	testCases := []struct {
+		name string
+		lo   int
+		hi   int
+		f    func(int) int
+		exp  int
+	}{
+		{name: "parabola", lo: -100, hi: 100, f: func(x int) int { return (x - 17) * (x - 17) }, exp: 17},
+		{name: "plateau", lo: 0, hi: 100, f: func(x int) int {
+			if x < 40 {
+				return 40 - x
+			}
+			return 0
+		}, exp: 40},
+		{name: "decreasing", lo: 0, hi: 10, f: func(x int) int { return -x }, exp: 9},
+		{name: "increasing", lo: 0, hi: 10, f: func(x int) int { return x }, exp: 0},
+		{name: "single", lo: 3, hi: 4, f: func(x int) int { return x }, exp: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := TernarySearchInt(tc.lo, tc.hi, tc.f)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %d, got %d (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+
+	if _, err := TernarySearchInt(3, 3, func(x int) int { return x }); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestGoldenSectionSearch(t *testing.T) {
+	calls := 0
+	f := func(x float64) float64 {
+		calls++
+		return (x-1.5)*(x-1.5) + 2
+	}
+	out, err := GoldenSectionSearch(-10.0, 10.0, 100, f)
+	if err != nil || stdmath.Abs(out-1.5) > 1e-6 {
+		t.Errorf("Expected 1.5, got %v (%v) instead\n", out, err)
+	}
+	if calls != 102 {
+		t.Errorf("Expected 102 calls, got %d instead\n", calls)
+	}
+
+	if out, err := GoldenSectionSearch(0.0, 6.0, 100, stdmath.Cos); err != nil || stdmath.Abs(out-stdmath.Pi) > 1e-6 {
+		t.Errorf("Expected %v, got %v (%v) instead\n", stdmath.Pi, out, err)
+	}
+	if _, err := GoldenSectionSearch(1.0, 0.0, 10, f); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"cmp"
+	"slices"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+// Compress は values の座標圧縮を行い, 昇順に並べた相異なる値 sorted と
+// sorted[indices[i]] == values[i] を満たす添字列 indices を返す.
+// Time: O(N log N)
+func Compress[T cmp.Ordered](values []T) (sorted []T, indices []int) {
+	sorted = make([]T, len(values))
+	copy(sorted, values)
+	slices.Sort(sorted)
+	sorted = Unique(sorted)
+	indices = make([]int, len(values))
+	for i, v := range values {
+		indices[i], _ = slices.BinarySearch(sorted, v)
+	}
+	return sorted, indices
+}
+
+// Run は ランレングス符号化における 値 Value とその連続する個数 Count の組である.
+type Run[T comparable] struct {
+	Value T
+	Count int
+}
+
+// RunLengthEncode は values を 隣接する等しい値をまとめた Run[T] の列に符号化する
+// Time: O(N)
+func RunLengthEncode[T comparable](values []T) []Run[T] {
+	runs := []Run[T]{}
+	for _, v := range values {
+		if len(runs) > 0 && runs[len(runs)-1].Value == v {
+			runs[len(runs)-1].Count++
+			continue
+		}
+		runs = append(runs, Run[T]{Value: v, Count: 1})
+	}
+	return runs
+}
+
+// RunLengthDecode は Run[T] の列を復号した列と error 値 nil を返す.
+// Count が負の Run が含まれる場合は ErrInvalidValue が error 値として返される.
+// Time: O(N + Σ Count)
+func RunLengthDecode[T comparable](runs []Run[T]) ([]T, error) {
+	size := 0
+	for _, r := range runs {
+		if r.Count < 0 {
+			return nil, errors.ErrInvalidValue
+		}
+		size += r.Count
+	}
+	values := make([]T, 0, size)
+	for _, r := range runs {
+		for i := 0; i < r.Count; i++ {
+			values = append(values, r.Value)
+		}
+	}
+	return values, nil
+}
+
+// Unique は 隣接する等しい値を 1 つにまとめた列を返す. sorted がソート済みの場合 相異なる値の列となる.
+// 結果は sorted の先頭部分を上書きして格納される.
+// Time: O(N)
+func Unique[T comparable](sorted []T) []T {
+	if len(sorted) == 0 {
+		return sorted
+	}
+	n := 1
+	for _, v := range sorted[1:] {
+		if v != sorted[n-1] {
+			sorted[n] = v
+			n++
+		}
+	}
+	return sorted[:n]
+}
+
+// Reverse は s の要素を逆順に並べ替える
+// Time: O(N)
+func Reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Rotate は s の要素を 左に k 個 巡回シフトする. すなわち s[k mod N] が先頭となる.
+// k は負でもよく, その場合は右に -k 個 巡回シフトする.
+// Time: O(N)
+func Rotate[T any](s []T, k int) {
+	n := len(s)
+	if n == 0 {
+		return
+	}
+	if k %= n; k < 0 {
+		k += n
+	}
+	Reverse(s[:k])
+	Reverse(s[k:])
+	Reverse(s)
+}
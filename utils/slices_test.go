@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
+
+func TestCompress(t *testing.T) {
+	testCases := []struct {
+		name    string
+		args    []int
+		sorted  []int
+		indices []int
+	}{
+		{
+			name:    "empty",
+			args:    []int{},
+			sorted:  []int{},
+			indices: []int{},
+		},
+		{
+			name:    "duplicates",
+			args:    []int{100, -5, 100, 7, -5},
+			sorted:  []int{-5, 7, 100},
+			indices: []int{2, 0, 2, 1, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted, indices := Compress(tc.args)
+			if !reflect.DeepEqual(sorted, tc.sorted) || !reflect.DeepEqual(indices, tc.indices) {
+				t.Errorf("Expected (%v, %v), got (%v, %v) instead\n", tc.sorted, tc.indices, sorted, indices)
+			}
+		})
+	}
+
+	args := []string{"b", "a", "b"}
+	if sorted, _ := Compress(args); !reflect.DeepEqual(args, []string{"b", "a", "b"}) || !reflect.DeepEqual(sorted, []string{"a", "b"}) {
+		t.Errorf("Expected input to be unchanged, got %v (%v) instead\n", args, sorted)
+	}
+}
+
+func TestRunLength(t *testing.T) {
+	args := []byte("aaabccdddd")
+	exp := []Run[byte]{{'a', 3}, {'b', 1}, {'c', 2}, {'d', 4}}
+	runs := RunLengthEncode(args)
+	if !reflect.DeepEqual(runs, exp) {
+		t.Fatalf("Expected %v, got %v instead\n", exp, runs)
+	}
+	out, err := RunLengthDecode(runs)
+	if err != nil || string(out) != string(args) {
+		t.Errorf("Expected %s, got %s (%v) instead\n", args, out, err)
+	}
+
+	if runs := RunLengthEncode([]int{}); len(runs) != 0 {
+		t.Errorf("Expected empty, got %v instead\n", runs)
+	}
+	if _, err := RunLengthDecode([]Run[int]{{1, 2}, {3, -1}}); err != errors.ErrInvalidValue {
+		t.Errorf("Expected %v, got %v instead\n", errors.ErrInvalidValue, err)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []int
+		exp  []int
+	}{
+		{name: "empty", args: []int{}, exp: []int{}},
+		{name: "single", args: []int{3}, exp: []int{3}},
+		{name: "sorted", args: []int{1, 1, 2, 3, 3, 3}, exp: []int{1, 2, 3}},
+		{name: "adjacent", args: []int{2, 2, 1, 2}, exp: []int{2, 1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if out := Unique(tc.args); !reflect.DeepEqual(out, tc.exp) {
+				t.Errorf("Expected %v, got %v instead\n", tc.exp, out)
+			}
+		})
+	}
+}
+
+func TestReverseRotate(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	Reverse(s)
+	if exp := []int{5, 4, 3, 2, 1}; !reflect.DeepEqual(s, exp) {
+		t.Errorf("Reverse: Expected %v, got %v instead\n", exp, s)
+	}
+
+	testCases := []struct {
+		name string
+		k    int
+		exp  []int
+	}{
+		{name: "zero", k: 0, exp: []int{1, 2, 3, 4, 5}},
+		{name: "left", k: 2, exp: []int{3, 4, 5, 1, 2}},
+		{name: "right", k: -1, exp: []int{5, 1, 2, 3, 4}},
+		{name: "wrap", k: 12, exp: []int{3, 4, 5, 1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := []int{1, 2, 3, 4, 5}
+			Rotate(s, tc.k)
+			if !reflect.DeepEqual(s, tc.exp) {
+				t.Errorf("Expected %v, got %v instead\n", tc.exp, s)
+			}
+		})
+	}
+
+	Rotate([]int{}, 3)
+}
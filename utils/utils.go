@@ -1,37 +1,120 @@
 package utils
 
 import (
-	math "project/hiden2000/acgo/lib/math"
+	"cmp"
+
+	errors "github.com/hiden2000/go_ds/errors"
 )
 
-// IntMaxは math.Intsのスライスnumsにおける最大値を返す
+// Max は nums における最大値と error 値 nil を返す.
+// nums が空の場合は ErrNotFound が error 値として返される.
+// 浮動小数点数の NaN は cmp.Compare と同様に 他の全ての値より小さいものとして扱う.
+// Time: O(N)
+func Max[T cmp.Ordered](nums ...T) (T, error) {
+	i, err := ArgMax(nums)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return nums[i], nil
+}
+
+// Min は nums における最小値と error 値 nil を返す.
+// nums が空の場合は ErrNotFound が error 値として返される.
+// 浮動小数点数の NaN は cmp.Compare と同様に 他の全ての値より小さいものとして扱う.
 // Time: O(N)
-func IntMax[T math.Ints](nums ...T) T {
-	var max T
+func Min[T cmp.Ordered](nums ...T) (T, error) {
+	i, err := ArgMin(nums)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return nums[i], nil
+}
+
+// MinMax は nums における最小値と最大値 および error 値 nil を返す.
+// nums が空の場合は ErrNotFound が error 値として返される.
+// Time: O(N)
+func MinMax[T cmp.Ordered](nums ...T) (min, max T, err error) {
 	if len(nums) == 0 {
-		return max
+		return min, max, errors.ErrNotFound
 	}
-	max = nums[0]
-	for _, e := range nums {
-		if max < e {
+	min, max = nums[0], nums[0]
+	for _, e := range nums[1:] {
+		if cmp.Less(e, min) {
+			min = e
+		}
+		if cmp.Less(max, e) {
 			max = e
 		}
 	}
-	return max
+	return min, max, nil
 }
 
-// IntMinは math.Intsのスライスnumsにおける最小値を返す
+// ArgMax は nums における最大値の添字 (複数ある場合は最小の添字) と error 値 nil を返す.
+// nums が空の場合は ErrNotFound が error 値として返される.
 // Time: O(N)
-func IntMin[T math.Ints](nums ...T) T {
-	var min T
+func ArgMax[T cmp.Ordered](nums []T) (int, error) {
 	if len(nums) == 0 {
-		return min
+		return -1, errors.ErrNotFound
 	}
-	min = nums[0]
-	for _, e := range nums {
-		if min > e {
-			min = e
+	idx := 0
+	for i, e := range nums {
+		if cmp.Less(nums[idx], e) {
+			idx = i
+		}
+	}
+	return idx, nil
+}
+
+// ArgMin は nums における最小値の添字 (複数ある場合は最小の添字) と error 値 nil を返す.
+// nums が空の場合は ErrNotFound が error 値として返される.
+// Time: O(N)
+func ArgMin[T cmp.Ordered](nums []T) (int, error) {
+	if len(nums) == 0 {
+		return -1, errors.ErrNotFound
+	}
+	idx := 0
+	for i, e := range nums {
+		if cmp.Less(e, nums[idx]) {
+			idx = i
 		}
 	}
-	return min
+	return idx, nil
+}
+
+// Clamp は v を [lo, hi] の範囲に丸めた値と error 値 nil を返す.
+// lo > hi の場合は ErrInvalidValue が error 値として返される.
+// Time: O(1)
+func Clamp[T cmp.Ordered](v, lo, hi T) (T, error) {
+	if cmp.Less(hi, lo) {
+		return v, errors.ErrInvalidValue
+	}
+	if cmp.Less(v, lo) {
+		return lo, nil
+	}
+	if cmp.Less(hi, v) {
+		return hi, nil
+	}
+	return v, nil
+}
+
+// Chmin は v < *p の場合に *p を v で更新し, 更新したかを返す
+// Time: O(1)
+func Chmin[T cmp.Ordered](p *T, v T) bool {
+	if cmp.Less(v, *p) {
+		*p = v
+		return true
+	}
+	return false
+}
+
+// Chmax は v > *p の場合に *p を v で更新し, 更新したかを返す
+// Time: O(1)
+func Chmax[T cmp.Ordered](p *T, v T) bool {
+	if cmp.Less(*p, v) {
+		*p = v
+		return true
+	}
+	return false
 }
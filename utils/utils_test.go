@@ -1,6 +1,11 @@
 package utils
 
-import "testing"
+import (
+	stdmath "math"
+	"testing"
+
+	errors "github.com/hiden2000/go_ds/errors"
+)
 
 func TestMin(t *testing.T) {
 	testCases := []struct {
@@ -27,10 +32,126 @@ func TestMin(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			out := IntMin(tc.args...)
-			if out != tc.exp {
+			out, err := Min(tc.args...)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %d, got %d (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+}
+
+func TestMax(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []float64
+		exp  float64
+	}{
+		{
+			name: "first",
+			args: []float64{5.5, 2, 3},
+			exp:  5.5,
+		},
+		{
+			name: "negative",
+			args: []float64{-1.5, -0.5, -2},
+			exp:  -0.5,
+		},
+		{
+			name: "inf",
+			args: []float64{1, stdmath.Inf(1), 3},
+			exp:  stdmath.Inf(1),
+		},
+		{
+			name: "nan",
+			args: []float64{stdmath.NaN(), 2, 1},
+			exp:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := Max(tc.args...)
+			if err != nil || out != tc.exp {
+				t.Errorf("Expected %v, got %v (%v) instead\n", tc.exp, out, err)
+			}
+		})
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	if _, err := Max[int](); err != errors.ErrNotFound {
+		t.Errorf("Max: Expected %v, got %v instead\n", errors.ErrNotFound, err)
+	}
+	if _, err := Min[string](); err != errors.ErrNotFound {
+		t.Errorf("Min: Expected %v, got %v instead\n", errors.ErrNotFound, err)
+	}
+	if _, _, err := MinMax[float32](); err != errors.ErrNotFound {
+		t.Errorf("MinMax: Expected %v, got %v instead\n", errors.ErrNotFound, err)
+	}
+	if _, err := ArgMax([]int{}); err != errors.ErrNotFound {
+		t.Errorf("ArgMax: Expected %v, got %v instead\n", errors.ErrNotFound, err)
+	}
+	if _, err := ArgMin[int](nil); err != errors.ErrNotFound {
+		t.Errorf("ArgMin: Expected %v, got %v instead\n", errors.ErrNotFound, err)
+	}
+}
+
+func TestMinMaxArg(t *testing.T) {
+	args := []string{"pear", "apple", "zebra", "apple", "zebra"}
+	min, max, err := MinMax(args...)
+	if err != nil || min != "apple" || max != "zebra" {
+		t.Errorf("MinMax: Expected (apple, zebra), got (%s, %s) (%v) instead\n", min, max, err)
+	}
+	// 同じ値が複数ある場合は 最小の添字を返す
+	if i, err := ArgMin(args); err != nil || i != 1 {
+		t.Errorf("ArgMin: Expected 1, got %d (%v) instead\n", i, err)
+	}
+	if i, err := ArgMax(args); err != nil || i != 2 {
+		t.Errorf("ArgMax: Expected 2, got %d (%v) instead\n", i, err)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	testCases := []struct {
+		name string
+		v    int
+		lo   int
+		hi   int
+		exp  int
+		err  error
+	}{
+		{name: "below", v: -5, lo: 0, hi: 10, exp: 0},
+		{name: "inside", v: 5, lo: 0, hi: 10, exp: 5},
+		{name: "above", v: 15, lo: 0, hi: 10, exp: 10},
+		{name: "point", v: 15, lo: 3, hi: 3, exp: 3},
+		{name: "invalid", v: 5, lo: 10, hi: 0, err: errors.ErrInvalidValue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := Clamp(tc.v, tc.lo, tc.hi)
+			if err != tc.err {
+				t.Fatalf("Expected %v, got %v instead\n", tc.err, err)
+			}
+			if err == nil && out != tc.exp {
 				t.Errorf("Expected %d, got %d instead\n", tc.exp, out)
 			}
 		})
 	}
 }
+
+func TestChminChmax(t *testing.T) {
+	v := 10
+	if !Chmin(&v, 3) || v != 3 {
+		t.Errorf("Chmin: Expected update to 3, got %d instead\n", v)
+	}
+	if Chmin(&v, 3) || v != 3 {
+		t.Errorf("Chmin: Expected no update, got %d instead\n", v)
+	}
+	if !Chmax(&v, 7) || v != 7 {
+		t.Errorf("Chmax: Expected update to 7, got %d instead\n", v)
+	}
+	if Chmax(&v, -1) || v != 7 {
+		t.Errorf("Chmax: Expected no update, got %d instead\n", v)
+	}
+}